@@ -44,6 +44,28 @@ import (
 	"github.com/alexisvisco/relint/rules/lint030"
 	"github.com/alexisvisco/relint/rules/lint031"
 	"github.com/alexisvisco/relint/rules/lint032"
+	"github.com/alexisvisco/relint/rules/lint033"
+	"github.com/alexisvisco/relint/rules/lint034"
+	"github.com/alexisvisco/relint/rules/lint035"
+	"github.com/alexisvisco/relint/rules/lint036"
+	"github.com/alexisvisco/relint/rules/lint037"
+	"github.com/alexisvisco/relint/rules/lint038"
+	"github.com/alexisvisco/relint/rules/lint039"
+	"github.com/alexisvisco/relint/rules/lint040"
+	"github.com/alexisvisco/relint/rules/lint041"
+	"github.com/alexisvisco/relint/rules/lint042"
+	"github.com/alexisvisco/relint/rules/lint043"
+	"github.com/alexisvisco/relint/rules/lint044"
+	"github.com/alexisvisco/relint/rules/lint045"
+	"github.com/alexisvisco/relint/rules/lint046"
+	"github.com/alexisvisco/relint/rules/lint047"
+	"github.com/alexisvisco/relint/rules/lint048"
+	"github.com/alexisvisco/relint/rules/lint049"
+	"github.com/alexisvisco/relint/rules/lint050"
+	"github.com/alexisvisco/relint/rules/lint051"
+	"github.com/alexisvisco/relint/rules/lint052"
+	"github.com/alexisvisco/relint/rules/lint053"
+	"github.com/alexisvisco/relint/rules/lint054"
 )
 
 // Analyzers is the list of all relint analyzers.
@@ -86,6 +108,28 @@ var Analyzers = []*analysis.Analyzer{
 	lint030.Analyzer,
 	lint031.Analyzer,
 	lint032.Analyzer,
+	lint033.Analyzer,
+	lint034.Analyzer,
+	lint035.Analyzer,
+	lint036.Analyzer,
+	lint037.Analyzer,
+	lint038.Analyzer,
+	lint039.Analyzer,
+	lint040.Analyzer,
+	lint041.Analyzer,
+	lint042.Analyzer,
+	lint043.Analyzer,
+	lint044.Analyzer,
+	lint045.Analyzer,
+	lint046.Analyzer,
+	lint047.Analyzer,
+	lint048.Analyzer,
+	lint049.Analyzer,
+	lint050.Analyzer,
+	lint051.Analyzer,
+	lint052.Analyzer,
+	lint053.Analyzer,
+	lint054.Analyzer,
 }
 
 func init() {
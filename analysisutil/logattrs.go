@@ -0,0 +1,210 @@
+package analysisutil
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// LogKey is an attribute key passed to slog, either as a key-value pair
+// argument, through an Attr constructor, or as a group name.
+type LogKey struct {
+	// Key is the constant string value of the key.
+	Key string
+	// Expr is the key expression (a literal or a named string constant).
+	Expr ast.Expr
+	// Value is the attribute value expression, or nil for group names and
+	// slog.Attr literals without a value.
+	Value ast.Expr
+	// IsGroup reports whether the key names a group (slog.Group, WithGroup).
+	IsGroup bool
+}
+
+// slogAttrFuncs are the log/slog Attr constructors taking (key, value).
+var slogAttrFuncs = map[string]bool{
+	"String":   true,
+	"Int":      true,
+	"Int64":    true,
+	"Uint64":   true,
+	"Float64":  true,
+	"Bool":     true,
+	"Time":     true,
+	"Duration": true,
+	"Any":      true,
+}
+
+// LogKeys returns the attribute keys owned by node. Each key of a logging
+// statement is owned by exactly one node, so analyzers visiting every
+// *ast.CallExpr and *ast.CompositeLit see each key once:
+//   - logging calls (see ParseLogCall) and With own their key-value pairs,
+//   - slog.String, slog.Int, ..., slog.Any own their key,
+//   - slog.Group and WithGroup own their group name (and, for slog.Group,
+//     the key-value pairs that follow it),
+//   - slog.Attr{Key: ...} literals own their Key field.
+//
+// slog.Attr values passed among key-value arguments are skipped by the
+// enclosing call: they are reported by their own constructor or literal.
+// Keys that are not constant strings are ignored.
+func LogKeys(pass *analysis.Pass, node ast.Node) []LogKey {
+	switch n := node.(type) {
+	case *ast.CallExpr:
+		return callLogKeys(pass, n)
+	case *ast.CompositeLit:
+		return attrLiteralKeys(pass, n)
+	}
+	return nil
+}
+
+func callLogKeys(pass *analysis.Pass, call *ast.CallExpr) []LogKey {
+	if lc, ok := ParseLogCall(pass, call); ok {
+		if lc.ArgsIndex < 0 {
+			return nil
+		}
+		return keyValueKeys(pass, call.Args, lc.ArgsIndex)
+	}
+
+	fn := CalledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "log/slog" {
+		return nil
+	}
+	isMethod := fn.Type().(*types.Signature).Recv() != nil
+
+	switch {
+	case fn.Name() == "With":
+		return keyValueKeys(pass, call.Args, 0)
+	case fn.Name() == "WithGroup" && isMethod:
+		return groupKey(pass, call.Args)
+	case fn.Name() == "Group" && !isMethod:
+		keys := groupKey(pass, call.Args)
+		return append(keys, keyValueKeys(pass, call.Args, 1)...)
+	case slogAttrFuncs[fn.Name()] && !isMethod:
+		if len(call.Args) != 2 {
+			return nil
+		}
+		if key, ok := ConstantString(pass, call.Args[0]); ok {
+			return []LogKey{{Key: key, Expr: call.Args[0], Value: call.Args[1]}}
+		}
+	}
+	return nil
+}
+
+// keyValueKeys walks slog "args ...any" arguments starting at start, where
+// each element is either a slog.Attr or a key followed by its value.
+func keyValueKeys(pass *analysis.Pass, args []ast.Expr, start int) []LogKey {
+	var keys []LogKey
+	for i := start; i < len(args); {
+		if IsSlogAttr(pass.TypesInfo.TypeOf(args[i])) {
+			i++
+			continue
+		}
+		var value ast.Expr
+		if i+1 < len(args) {
+			value = args[i+1]
+		}
+		if key, ok := ConstantString(pass, args[i]); ok {
+			keys = append(keys, LogKey{Key: key, Expr: args[i], Value: value})
+		}
+		i += 2
+	}
+	return keys
+}
+
+func groupKey(pass *analysis.Pass, args []ast.Expr) []LogKey {
+	if len(args) == 0 {
+		return nil
+	}
+	if key, ok := ConstantString(pass, args[0]); ok {
+		return []LogKey{{Key: key, Expr: args[0], IsGroup: true}}
+	}
+	return nil
+}
+
+func attrLiteralKeys(pass *analysis.Pass, lit *ast.CompositeLit) []LogKey {
+	if !IsSlogAttr(pass.TypesInfo.TypeOf(lit)) || len(lit.Elts) == 0 {
+		return nil
+	}
+
+	var keyExpr, valueExpr ast.Expr
+	for i, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			// Positional form: slog.Attr{"key", value}.
+			switch i {
+			case 0:
+				keyExpr = elt
+			case 1:
+				valueExpr = elt
+			}
+			continue
+		}
+		field, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch field.Name {
+		case "Key":
+			keyExpr = kv.Value
+		case "Value":
+			valueExpr = kv.Value
+		}
+	}
+	if keyExpr == nil {
+		return nil
+	}
+	if key, ok := ConstantString(pass, keyExpr); ok {
+		return []LogKey{{Key: key, Expr: keyExpr, Value: valueExpr}}
+	}
+	return nil
+}
+
+// IsSlogAttr reports whether t is slog.Attr.
+func IsSlogAttr(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "log/slog" && obj.Name() == "Attr"
+}
+
+// ConstantString returns the value of expr when it is a constant string,
+// such as a string literal or a named string constant.
+func ConstantString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// dotNotation maps slog keys to the dotted keys they must be written as,
+// set with SetDotNotation.
+var dotNotation = map[string]string{}
+
+// SetDotNotation declares the slog keys that must use dot notation. spec is a
+// comma-separated list of key=dotted pairs, e.g.
+// "error=error.message,userId=user.id".
+func SetDotNotation(spec string) {
+	m := make(map[string]string)
+	for _, pair := range strings.Split(spec, ",") {
+		key, dotted, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok {
+			m[strings.TrimSpace(key)] = strings.TrimSpace(dotted)
+		}
+	}
+	dotNotation = m
+}
+
+// DotNotation returns the dotted key configured for key with SetDotNotation.
+func DotNotation(key string) (string, bool) {
+	dotted, ok := dotNotation[key]
+	return dotted, ok
+}
+
+// HasDotNotation reports whether any dot-notation key is configured.
+func HasDotNotation() bool {
+	return len(dotNotation) > 0
+}
@@ -0,0 +1,214 @@
+package analysisutil
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// LogFunc describes where the arguments of a logging function start.
+// Indexes are -1 when the function has no such arguments.
+type LogFunc struct {
+	// MsgIndex is the index of the message argument.
+	MsgIndex int
+	// ArgsIndex is the index where alternating key-value arguments start.
+	ArgsIndex int
+	// AttrsIndex is the index where slog.Attr arguments start.
+	AttrsIndex int
+}
+
+// LogCall is a call to a recognized logging function or method.
+type LogCall struct {
+	LogFunc
+	Call *ast.CallExpr
+	// Func is the called function or method.
+	Func *types.Func
+	// PackageLevel reports whether the call is a package-level function
+	// (slog.Info) rather than a method (logger.Info).
+	PackageLevel bool
+}
+
+// slogFuncs lists the log/slog functions and *slog.Logger methods that emit records.
+var slogFuncs = map[string]LogFunc{
+	"Debug":        {MsgIndex: 0, ArgsIndex: 1, AttrsIndex: -1},
+	"Info":         {MsgIndex: 0, ArgsIndex: 1, AttrsIndex: -1},
+	"Warn":         {MsgIndex: 0, ArgsIndex: 1, AttrsIndex: -1},
+	"Error":        {MsgIndex: 0, ArgsIndex: 1, AttrsIndex: -1},
+	"DebugContext": {MsgIndex: 1, ArgsIndex: 2, AttrsIndex: -1},
+	"InfoContext":  {MsgIndex: 1, ArgsIndex: 2, AttrsIndex: -1},
+	"WarnContext":  {MsgIndex: 1, ArgsIndex: 2, AttrsIndex: -1},
+	"ErrorContext": {MsgIndex: 1, ArgsIndex: 2, AttrsIndex: -1},
+	"Log":          {MsgIndex: 2, ArgsIndex: 3, AttrsIndex: -1},
+	"LogAttrs":     {MsgIndex: 2, ArgsIndex: -1, AttrsIndex: 3},
+}
+
+// configuredLogFuncs holds the additional logging functions declared with
+// SetLogFuncs, keyed by normalized fully qualified name.
+var configuredLogFuncs = map[string]LogFunc{}
+
+// SetLogFuncs declares additional logging functions and methods. spec is a
+// comma-separated list of entries of the form
+//
+//	<qualified-name>[:msg=N][:args=N][:attrs=N]
+//
+// where <qualified-name> is a function ("example.com/logx.Info") or a method
+// ("(*go.uber.org/zap.SugaredLogger).Infow") and N is the index of the
+// message, first key-value argument, and first slog.Attr argument. Omitted
+// indexes mean the function has no such arguments.
+func SetLogFuncs(spec string) error {
+	funcs := make(map[string]LogFunc)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			return fmt.Errorf("invalid log function %q: missing name", entry)
+		}
+		lf := LogFunc{MsgIndex: -1, ArgsIndex: -1, AttrsIndex: -1}
+		for _, opt := range parts[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(opt), "=")
+			if !ok {
+				return fmt.Errorf("invalid log function %q: option %q must be key=index", entry, opt)
+			}
+			idx, err := strconv.Atoi(value)
+			if err != nil || idx < 0 {
+				return fmt.Errorf("invalid log function %q: index %q must be a non-negative integer", entry, value)
+			}
+			switch key {
+			case "msg":
+				lf.MsgIndex = idx
+			case "args":
+				lf.ArgsIndex = idx
+			case "attrs":
+				lf.AttrsIndex = idx
+			default:
+				return fmt.Errorf("invalid log function %q: unknown option %q (want msg, args or attrs)", entry, key)
+			}
+		}
+		funcs[normalizeFuncName(name)] = lf
+	}
+	configuredLogFuncs = funcs
+	return nil
+}
+
+// ParseLogCall reports whether call is a logging call and, if so, where its
+// message and attribute arguments start. Recognized calls are:
+//   - log/slog functions and *slog.Logger methods, including methods promoted
+//     from an embedded *slog.Logger,
+//   - methods of types wrapping a slog.Logger field that mirror a
+//     *slog.Logger method signature,
+//   - functions and methods declared with SetLogFuncs.
+func ParseLogCall(pass *analysis.Pass, call *ast.CallExpr) (*LogCall, bool) {
+	fn := CalledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil {
+		return nil, false
+	}
+	sig := fn.Type().(*types.Signature)
+	lc := &LogCall{Call: call, Func: fn, PackageLevel: sig.Recv() == nil}
+
+	if lf, ok := configuredLogFuncs[normalizeFuncName(fn.FullName())]; ok {
+		lc.LogFunc = lf
+		return lc, true
+	}
+
+	lf, ok := slogFuncs[fn.Name()]
+	if !ok {
+		return nil, false
+	}
+	if fn.Pkg().Path() == "log/slog" || wrapsSlogLogger(fn) {
+		lc.LogFunc = lf
+		return lc, true
+	}
+	return nil, false
+}
+
+// wrapsSlogLogger reports whether fn is a method on a struct holding a
+// slog.Logger field whose signature mirrors the *slog.Logger method of the
+// same name.
+func wrapsSlogLogger(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil {
+		return false
+	}
+	recv := sig.Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	st, ok := recv.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		logger := slogLoggerType(st.Field(i).Type())
+		if logger == nil {
+			continue
+		}
+		obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(logger), false, logger.Obj().Pkg(), fn.Name())
+		slogMethod, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+		if sameParams(sig, slogMethod.Type().(*types.Signature)) {
+			return true
+		}
+	}
+	return false
+}
+
+// slogLoggerType returns the slog.Logger named type when t is slog.Logger or
+// *slog.Logger.
+func slogLoggerType(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "log/slog" || obj.Name() != "Logger" {
+		return nil
+	}
+	return named
+}
+
+func sameParams(a, b *types.Signature) bool {
+	if a.Variadic() != b.Variadic() || a.Params().Len() != b.Params().Len() {
+		return false
+	}
+	for i := 0; i < a.Params().Len(); i++ {
+		if !types.Identical(a.Params().At(i).Type(), b.Params().At(i).Type()) {
+			return false
+		}
+	}
+	return true
+}
+
+// CalledFunc returns the function or method called by call, or nil.
+func CalledFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.Ident:
+		ident = fun
+	default:
+		return nil
+	}
+	fn, _ := pass.TypesInfo.Uses[ident].(*types.Func)
+	return fn
+}
+
+// normalizeFuncName makes pointer and value receivers equivalent:
+// "(*pkg.T).M" and "(pkg.T).M" both become "(pkg.T).M".
+func normalizeFuncName(name string) string {
+	return strings.Replace(name, "(*", "(", 1)
+}
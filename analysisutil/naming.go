@@ -0,0 +1,152 @@
+package analysisutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ExpectedRouteFile returns the file a handler route method must live in,
+// e.g. ("Asset", "ListAssets") -> "list.go". Every upper-case letter starts a
+// word, as LINT-022 has always required: ("Asset", "GetByID") ->
+// "get_by_i_d.go". handlerName is the receiver name without "Handler".
+func ExpectedRouteFile(handlerName, methodName string) string {
+	return expectedFile(upperSnake(handlerName), upperSnake(methodName))
+}
+
+// ExpectedMethodFile returns the file a store/service method must live in,
+// keeping acronyms together: ("User", "GetByEmail") -> "get_by_email.go" and
+// ("User", "ListByUserID") -> "list_by_user_id.go". ownerName is the receiver
+// name without its layer suffix (Store, Service).
+func ExpectedMethodFile(ownerName, methodName string) string {
+	return expectedFile(ToSnake(ownerName), ToSnake(methodName))
+}
+
+func expectedFile(ownerSnake, methodSnake string) string {
+	if routePart := NormalizeRoutePart(ownerSnake, methodSnake); routePart != "" {
+		return routePart + ".go"
+	}
+	return methodSnake + ".go"
+}
+
+// NormalizeRoutePart removes ownerSnake (and its plural form) from the start
+// or end of routeSnake. It returns "" when nothing but the owner name remains.
+func NormalizeRoutePart(ownerSnake, routeSnake string) string {
+	routePart := routeSnake
+	aliases := []string{ownerSnake, Pluralize(ownerSnake)}
+	for _, alias := range aliases {
+		routePart = strings.TrimPrefix(routePart, alias+"_")
+		routePart = strings.TrimSuffix(routePart, "_"+alias)
+	}
+	routePart = strings.Trim(routePart, "_")
+	if routePart == ownerSnake || routePart == Pluralize(ownerSnake) {
+		return ""
+	}
+	return routePart
+}
+
+// Pluralize returns the simple English plural form of s.
+func Pluralize(s string) string {
+	if strings.HasSuffix(s, "y") && len(s) > 1 {
+		prev := s[len(s)-2]
+		if !strings.ContainsRune("aeiou", rune(prev)) {
+			return s[:len(s)-1] + "ies"
+		}
+	}
+	if strings.HasSuffix(s, "s") || strings.HasSuffix(s, "x") || strings.HasSuffix(s, "z") ||
+		strings.HasSuffix(s, "ch") || strings.HasSuffix(s, "sh") {
+		return s + "es"
+	}
+	return s + "s"
+}
+
+// IsPlural reports whether name looks like an English plural (users, assets),
+// excluding common singular endings (-ss, -us, -is).
+func IsPlural(name string) bool {
+	name = strings.TrimSpace(strings.ToLower(name))
+	if len(name) < 2 {
+		return false
+	}
+	if !strings.HasSuffix(name, "s") {
+		return false
+	}
+
+	// Common singular endings that should not be treated as plural.
+	if strings.HasSuffix(name, "ss") || strings.HasSuffix(name, "us") || strings.HasSuffix(name, "is") {
+		return false
+	}
+
+	return true
+}
+
+// ToSnake converts a PascalCase or camelCase identifier to snake_case,
+// keeping acronyms together: GetByID -> get_by_id.
+func ToSnake(s string) string {
+	return strings.Join(SplitWords(s), "_")
+}
+
+// ToLowerCamel converts a name to lowerCamelCase, writing acronyms as words:
+// UserID, user_id and user-id -> userId.
+func ToLowerCamel(s string) string {
+	words := SplitWords(s)
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
+
+// upperSnake converts an identifier to snake_case, starting a word at every
+// upper-case letter: GetByID -> get_by_i_d.
+func upperSnake(s string) string {
+	var result []rune
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			result = append(result, '_')
+		}
+		result = append(result, unicode.ToLower(r))
+	}
+	return string(result)
+}
+
+// SplitWords splits a name into lowercase words on '_', '-', '.' and case
+// changes, keeping acronyms together: HTTPStatusCode -> http, status, code;
+// ListByUserID, list_by_user_id and list-by-user-id -> list, by, user, id.
+func SplitWords(name string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == '.' }) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			if !unicode.IsUpper(runes[i]) {
+				continue
+			}
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])
+			if prevLower || nextLower {
+				words = append(words, strings.ToLower(string(runes[start:i])))
+				start = i
+			}
+		}
+		words = append(words, strings.ToLower(string(runes[start:])))
+	}
+	return words
+}
+
+// HasVerbPrefix reports whether name starts with one of prefixes followed by
+// the end of the name or an upper-case letter (Get, GetByID, but not Getaway).
+func HasVerbPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rest := name[len(prefix):]
+		if rest == "" {
+			return true
+		}
+		r, _ := utf8.DecodeRuneInString(rest)
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,472 @@
+package analysisutil
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Route is an HTTP route registration found in a package.
+type Route struct {
+	// Method is the upper-case HTTP method, or "" when the pattern has none.
+	Method string
+	// Path is the path part of the pattern.
+	Path string
+	// Pattern is the pattern expression (a literal or a named string constant).
+	Pattern ast.Expr
+	// Call is the call carrying the pattern (WithPattern(...), huma.Register(...)
+	// or huma.Get(...)).
+	Call *ast.CallExpr
+	// Huma reports whether the route is registered with huma.
+	Huma bool
+	// Operation is the huma.Operation literal of a huma.Register call, or nil.
+	Operation *ast.CompositeLit
+	// Handler is the handler function signature, or nil when unresolved.
+	Handler *types.Signature
+	// HandlerFunc is the handler function or method, or nil when the handler
+	// is unresolved or a function literal.
+	HandlerFunc *types.Func
+	// Input and Output are the *Input/*Output types of the route, or nil.
+	Input  *types.Named
+	Output *types.Named
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// PathParams returns the names of the {param} segments of path, without the
+// "..." wildcard suffix.
+func PathParams(path string) []string {
+	var out []string
+	for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		out = append(out, strings.TrimSuffix(m[1], "..."))
+	}
+	return out
+}
+
+// SplitPattern splits an http.ServeMux pattern ("GET /users/{id}") into its
+// method and path.
+func SplitPattern(pattern string) (method, path string) {
+	pattern = strings.TrimSpace(pattern)
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok || path == "" {
+		return "", pattern
+	}
+	return strings.ToUpper(method), strings.TrimSpace(path)
+}
+
+// humaPath is the import path of huma v2.
+const humaPath = "github.com/danielgtaylor/huma/v2"
+
+// FindRoutes returns the routes registered in files:
+//   - httpapi calls to WithPattern with a constant pattern,
+//   - huma.Register calls whose huma.Operation literal has a constant Method
+//     and Path,
+//   - huma.Get, huma.Post, ... calls with a constant path.
+//
+// For WithPattern, the handler, Input and Output types are resolved from the
+// registration expression around the call:
+//   - function-typed arguments (method values, functions, function literals)
+//     of the calls chained with or enclosing WithPattern,
+//   - type arguments of those calls (Register[GetUserInput, GetUserOutput]),
+//   - otherwise, the signature of the enclosing function.
+//
+// For huma, they are resolved from the handler argument.
+//
+// Input and Output are the parameter and result types (or type arguments)
+// whose name ends with Input and Output.
+func FindRoutes(pass *analysis.Pass, files []*ast.File) []Route {
+	var routes []Route
+	for _, f := range files {
+		var stack []ast.Node
+		ast.Inspect(f, func(n ast.Node) bool {
+			if n == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+			stack = append(stack, n)
+
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if route, ok := humaRoute(pass, call); ok {
+				routes = append(routes, route)
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "WithPattern" || len(call.Args) == 0 {
+				return true
+			}
+			pattern, ok := ConstantString(pass, call.Args[0])
+			if !ok {
+				return true
+			}
+
+			route := Route{Pattern: call.Args[0], Call: call}
+			route.Method, route.Path = SplitPattern(pattern)
+			resolveRouteTypes(pass, &route, registrationCalls(stack))
+			if route.Input == nil && route.Output == nil {
+				if fn := enclosingFunc(pass, stack); fn != nil {
+					sig := fn.Type().(*types.Signature)
+					route.Input, route.Output = signatureTypes(sig)
+					if route.Input != nil || route.Output != nil {
+						route.Handler, route.HandlerFunc = sig, fn
+					}
+				}
+			}
+			routes = append(routes, route)
+			return true
+		})
+	}
+	return routes
+}
+
+// humaRoute returns the route registered by a huma.Register, huma.Get, ...
+// call.
+func humaRoute(pass *analysis.Pass, call *ast.CallExpr) (Route, bool) {
+	fn, ok := pass.TypesInfo.Uses[funcIdent(call.Fun)].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != humaPath {
+		return Route{}, false
+	}
+
+	route := Route{Call: call, Huma: true}
+	switch name := fn.Name(); name {
+	case "Register":
+		if len(call.Args) < 2 {
+			return Route{}, false
+		}
+		lit, ok := humaOperation(pass, call.Args[1])
+		if !ok {
+			return Route{}, false
+		}
+		route.Operation = lit
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			switch key.Name {
+			case "Method":
+				route.Method, _ = ConstantString(pass, kv.Value)
+				route.Method = strings.ToUpper(route.Method)
+			case "Path":
+				if path, ok := ConstantString(pass, kv.Value); ok {
+					route.Pattern, route.Path = kv.Value, path
+				}
+			}
+		}
+		if route.Method == "" || route.Pattern == nil {
+			return Route{}, false
+		}
+	case "Get", "Post", "Put", "Patch", "Delete", "Head", "Options":
+		if len(call.Args) < 2 {
+			return Route{}, false
+		}
+		path, ok := ConstantString(pass, call.Args[1])
+		if !ok {
+			return Route{}, false
+		}
+		route.Method, route.Path, route.Pattern = strings.ToUpper(name), path, call.Args[1]
+	default:
+		return Route{}, false
+	}
+
+	resolveRouteTypes(pass, &route, []*ast.CallExpr{call})
+	return route, true
+}
+
+// humaOperation returns the huma.Operation literal of expr (huma.Operation{}
+// or &huma.Operation{}).
+func humaOperation(pass *analysis.Pass, expr ast.Expr) (*ast.CompositeLit, bool) {
+	expr = ast.Unparen(expr)
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = ast.Unparen(u.X)
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, false
+	}
+	named, ok := pass.TypesInfo.TypeOf(lit).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != humaPath || named.Obj().Name() != "Operation" {
+		return nil, false
+	}
+	return lit, true
+}
+
+// funcIdent returns the identifier naming the function called by fun (f,
+// pkg.F, f[T], pkg.F[T1, T2]), or nil.
+func funcIdent(fun ast.Expr) *ast.Ident {
+	switch e := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return genericFuncIdent(e)
+	}
+	return nil
+}
+
+// registrationCalls returns the calls forming the registration expression of
+// the WithPattern call at the top of stack: the calls it is chained with and
+// the calls it is an argument of, up to the enclosing statement.
+func registrationCalls(stack []ast.Node) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.CallExpr:
+			calls = append(calls, n)
+			calls = append(calls, chainedCalls(n.Fun)...)
+		case ast.Expr:
+		case ast.Stmt, ast.Decl, ast.Spec:
+			return calls
+		}
+	}
+	return calls
+}
+
+// enclosingFunc returns the function declared by the innermost function
+// declaration in stack, or nil when the innermost function is a literal.
+func enclosingFunc(pass *analysis.Pass, stack []ast.Node) *types.Func {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			fn, _ := pass.TypesInfo.Defs[n.Name].(*types.Func)
+			return fn
+		case *ast.FuncLit:
+			return nil
+		}
+	}
+	return nil
+}
+
+// chainedCalls returns the receiver calls of a method chain (a().b().c).
+func chainedCalls(fun ast.Expr) []*ast.CallExpr {
+	var calls []*ast.CallExpr
+	for {
+		sel, ok := ast.Unparen(fun).(*ast.SelectorExpr)
+		if !ok {
+			return calls
+		}
+		call, ok := ast.Unparen(sel.X).(*ast.CallExpr)
+		if !ok {
+			return calls
+		}
+		calls = append(calls, call)
+		fun = call.Fun
+	}
+}
+
+func resolveRouteTypes(pass *analysis.Pass, route *Route, calls []*ast.CallExpr) {
+	for _, call := range calls {
+		for _, arg := range call.Args {
+			sig, ok := pass.TypesInfo.TypeOf(arg).(*types.Signature)
+			if !ok {
+				continue
+			}
+			input, output := signatureTypes(sig)
+			if input != nil || output != nil {
+				route.Handler, route.Input, route.Output = sig, input, output
+				route.HandlerFunc = funcOf(pass, arg)
+				return
+			}
+		}
+	}
+
+	for _, call := range calls {
+		ident := funcIdent(call.Fun)
+		if ident == nil {
+			continue
+		}
+		inst, ok := pass.TypesInfo.Instances[ident]
+		if !ok {
+			continue
+		}
+		for i := 0; i < inst.TypeArgs.Len(); i++ {
+			assignRouteType(route, inst.TypeArgs.At(i))
+		}
+		if route.Input != nil || route.Output != nil {
+			return
+		}
+	}
+}
+
+// funcOf returns the function or method referred to by expr (f, pkg.F,
+// h.Method), or nil.
+func funcOf(pass *analysis.Pass, expr ast.Expr) *types.Func {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return nil
+	}
+	fn, _ := pass.TypesInfo.Uses[ident].(*types.Func)
+	return fn
+}
+
+func genericFuncIdent(fun ast.Expr) *ast.Ident {
+	var x ast.Expr
+	switch e := fun.(type) {
+	case *ast.IndexExpr:
+		x = e.X
+	case *ast.IndexListExpr:
+		x = e.X
+	}
+	switch e := ast.Unparen(x).(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	}
+	return nil
+}
+
+// signatureTypes returns the *Input parameter and *Output result of sig.
+func signatureTypes(sig *types.Signature) (input, output *types.Named) {
+	for i := 0; i < sig.Params().Len() && input == nil; i++ {
+		if named := namedStruct(sig.Params().At(i).Type()); named != nil && strings.HasSuffix(named.Obj().Name(), "Input") {
+			input = named
+		}
+	}
+	for i := 0; i < sig.Results().Len() && output == nil; i++ {
+		if named := namedStruct(sig.Results().At(i).Type()); named != nil && strings.HasSuffix(named.Obj().Name(), "Output") {
+			output = named
+		}
+	}
+	return input, output
+}
+
+func assignRouteType(route *Route, t types.Type) {
+	named := namedStruct(t)
+	if named == nil {
+		return
+	}
+	switch name := named.Obj().Name(); {
+	case strings.HasSuffix(name, "Input") && route.Input == nil:
+		route.Input = named
+	case strings.HasSuffix(name, "Output") && route.Output == nil:
+		route.Output = named
+	}
+}
+
+// namedStruct returns the named struct type of t or *t.
+func namedStruct(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+	return named
+}
+
+// TaggedField is a struct field carrying a parameter tag (path, query, ...).
+type TaggedField struct {
+	Field *types.Var
+	// Name is the tag name, without options.
+	Name string
+	// Options are the comma-separated tag options after the name.
+	Options []string
+}
+
+// TaggedFields returns the fields of the struct t (including fields of
+// embedded structs) with a non-empty, non-"-" tag key.
+func TaggedFields(t *types.Named, key string) []TaggedField {
+	var out []TaggedField
+	var visit func(st *types.Struct, seen map[*types.Struct]bool)
+	visit = func(st *types.Struct, seen map[*types.Struct]bool) {
+		if seen[st] {
+			return
+		}
+		seen[st] = true
+		for i := 0; i < st.NumFields(); i++ {
+			field := st.Field(i)
+			tag, ok := reflect.StructTag(st.Tag(i)).Lookup(key)
+			if !ok && field.Embedded() {
+				if named := namedStruct(field.Type()); named != nil {
+					visit(named.Underlying().(*types.Struct), seen)
+				}
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			if parts[0] == "" || parts[0] == "-" {
+				continue
+			}
+			out = append(out, TaggedField{Field: field, Name: parts[0], Options: parts[1:]})
+		}
+	}
+	visit(t.Underlying().(*types.Struct), make(map[*types.Struct]bool))
+	return out
+}
+
+// PayloadBody returns the body of the Input/Output type t and its json
+// fields. When t has a Body field, body is that field and fields are the
+// fields of its struct type; otherwise body is nil and fields are the
+// exported fields of t that carry no path, query or header tag. The Name of
+// the returned fields is their json name (the Go name when untagged); fields
+// tagged json:"-" are skipped.
+func PayloadBody(t *types.Named) (body *types.Var, fields []TaggedField) {
+	st := t.Underlying().(*types.Struct)
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Name() == "Body" {
+			if s, ok := deref(f.Type()).Underlying().(*types.Struct); ok {
+				return f, jsonFields(s)
+			}
+			return f, nil
+		}
+	}
+	return nil, jsonFields(st)
+}
+
+func jsonFields(st *types.Struct) []TaggedField {
+	var out []TaggedField
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		if !f.Exported() || f.Embedded() || isParamTag(tag) {
+			continue
+		}
+		parts := strings.Split(tag.Get("json"), ",")
+		if parts[0] == "-" {
+			continue
+		}
+		name := parts[0]
+		if name == "" {
+			name = f.Name()
+		}
+		out = append(out, TaggedField{Field: f, Name: name, Options: parts[1:]})
+	}
+	return out
+}
+
+func isParamTag(tag reflect.StructTag) bool {
+	for _, key := range []string{"path", "query", "header"} {
+		if _, ok := tag.Lookup(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
@@ -0,0 +1,134 @@
+package analysisutil
+
+import (
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SetTagName returns the struct tag literal tagLit (including its quotes) with
+// the name of key set to name, keeping the key's options (json:"x,omitempty").
+// The key is appended when missing. ok is false when tagLit cannot be parsed.
+func SetTagName(tagLit, key, name string) (string, bool) {
+	if len(tagLit) < 2 || (tagLit[0] != '`' && tagLit[0] != '"') {
+		return "", false
+	}
+	tag, err := strconv.Unquote(tagLit)
+	if err != nil {
+		return "", false
+	}
+	entries, ok := parseTag(tag)
+	if !ok {
+		return "", false
+	}
+
+	found := false
+	for i, e := range entries {
+		if e.key != key {
+			continue
+		}
+		_, opts, hasOpts := strings.Cut(e.value, ",")
+		entries[i].value = name
+		if hasOpts {
+			entries[i].value += "," + opts
+		}
+		found = true
+	}
+	if !found {
+		entries = append(entries, tagEntry{key: key, value: name})
+	}
+
+	parts := make([]string, len(entries))
+	for i, e := range entries {
+		parts[i] = e.key + ":" + strconv.Quote(e.value)
+	}
+	updated := strings.Join(parts, " ")
+	if tagLit[0] == '`' && !strings.Contains(updated, "`") {
+		return "`" + updated + "`", true
+	}
+	return strconv.Quote(updated), true
+}
+
+type tagEntry struct {
+	key   string
+	value string
+}
+
+// parseTag splits a conventional struct tag (key:"value" pairs separated by
+// spaces) into its entries.
+func parseTag(tag string) ([]tagEntry, bool) {
+	var entries []tagEntry
+	for {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			return entries, true
+		}
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, false
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		j := 1
+		for j < len(tag) && tag[j] != '"' {
+			if tag[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(tag) {
+			return nil, false
+		}
+		value, err := strconv.Unquote(tag[:j+1])
+		if err != nil {
+			return nil, false
+		}
+		entries = append(entries, tagEntry{key: key, value: value})
+		tag = tag[j+1:]
+	}
+}
+
+// TagName returns the name of the key tag of field (without options), or
+// false when the field has no such tag or its name is empty or "-".
+func TagName(field *ast.Field, key string) (string, bool) {
+	if field.Tag == nil || field.Tag.Kind != token.STRING {
+		return "", false
+	}
+	rawTag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false
+	}
+	name := strings.Split(reflect.StructTag(rawTag).Get(key), ",")[0]
+	if name == "" || name == "-" {
+		return "", false
+	}
+	return name, true
+}
+
+// IsLowerCamel reports whether s is lowerCamelCase (letters and digits,
+// starting with a lowercase letter).
+func IsLowerCamel(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if i == 0 {
+			if !unicode.IsLower(r) {
+				return false
+			}
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			continue
+		}
+		return false
+	}
+	return true
+}
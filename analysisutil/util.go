@@ -1,9 +1,11 @@
 package analysisutil
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -12,16 +14,31 @@ import (
 
 // IsSlogCall reports whether call is a call to slog.X or (*slog.Logger).X.
 func IsSlogCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	_, ok := slogCallForm(pass, call)
+	return ok
+}
+
+// IsPackageSlogCall reports whether call is a call to a package-level
+// log/slog function (slog.Info, slog.Default, ...) rather than a
+// *slog.Logger method.
+func IsPackageSlogCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	packageLevel, ok := slogCallForm(pass, call)
+	return ok && packageLevel
+}
+
+// slogCallForm reports whether call is a slog call and whether it uses the
+// package-level form.
+func slogCallForm(pass *analysis.Pass, call *ast.CallExpr) (packageLevel bool, ok bool) {
 	sel, ok := call.Fun.(*ast.SelectorExpr)
 	if !ok {
-		return false
+		return false, false
 	}
 	// slog.Info, slog.Error, etc. (package-level)
 	if ident, ok := sel.X.(*ast.Ident); ok {
 		obj := pass.TypesInfo.Uses[ident]
 		if obj != nil {
 			if pkgName, ok := obj.(*types.PkgName); ok {
-				return pkgName.Imported().Path() == "log/slog"
+				return true, pkgName.Imported().Path() == "log/slog"
 			}
 		}
 	}
@@ -35,11 +52,11 @@ func IsSlogCall(pass *analysis.Pass, call *ast.CallExpr) bool {
 		if named, ok := t.(*types.Named); ok {
 			obj := named.Obj()
 			if obj.Pkg() != nil && obj.Pkg().Path() == "log/slog" && obj.Name() == "Logger" {
-				return true
+				return false, true
 			}
 		}
 	}
-	return false
+	return false, false
 }
 
 // IsInPackage reports whether pass.Pkg.Name() equals pkgName.
@@ -146,3 +163,136 @@ func ContainsExportedVar(file *ast.File, name string) bool {
 	}
 	return false
 }
+
+// ModuleRoot returns the directory of the go.mod enclosing the package being
+// analyzed, or "" when there is none.
+func ModuleRoot(pass *analysis.Pass) string {
+	if len(pass.Files) == 0 {
+		return ""
+	}
+	dir := filepath.Dir(pass.Fset.File(pass.Files[0].Pos()).Name())
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// ResolveModulePath resolves a configured file path: absolute paths are
+// returned as is, relative paths are resolved against the module root.
+func ResolveModulePath(pass *analysis.Pass, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	if root := ModuleRoot(pass); root != "" {
+		return filepath.Join(root, path)
+	}
+	return path
+}
+
+// ModulePosition returns pos as "file:line", with file relative to the module
+// root when possible. It is used to refer to declarations of other packages in
+// module-wide diagnostics.
+func ModulePosition(pass *analysis.Pass, pos token.Pos) string {
+	p := pass.Fset.Position(pos)
+	file := p.Filename
+	if root := ModuleRoot(pass); root != "" {
+		if rel, err := filepath.Rel(root, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+	}
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(file), p.Line)
+}
+
+// MainReportPos returns the position of func main, or the package clause.
+// Rules aggregating package facts report their module-wide diagnostics there.
+func MainReportPos(pass *analysis.Pass) token.Pos {
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if ok && fn.Recv == nil && fn.Name.Name == "main" {
+				return fn.Name.Pos()
+			}
+		}
+	}
+	return pass.Files[0].Name.Pos()
+}
+
+// ContainsPos reports whether pos belongs to one of the files of pass.
+func ContainsPos(pass *analysis.Pass, pos token.Pos) bool {
+	return FileOf(pass, pos) != nil
+}
+
+// FileOf returns the file of pass containing pos, or nil.
+func FileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, f := range pass.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			return f
+		}
+	}
+	return nil
+}
+
+// ImportedPkgName returns the package name declared by spec, either its
+// explicit name or the implicit one, or nil.
+func ImportedPkgName(pass *analysis.Pass, spec *ast.ImportSpec) *types.PkgName {
+	if spec.Name != nil {
+		pkgName, _ := pass.TypesInfo.Defs[spec.Name].(*types.PkgName)
+		return pkgName
+	}
+	pkgName, _ := pass.TypesInfo.Implicits[spec].(*types.PkgName)
+	return pkgName
+}
+
+// IsContextType reports whether t is context.Context.
+func IsContextType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
+// IsErrorType reports whether t is the error interface itself.
+func IsErrorType(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// ImplementsError reports whether t implements error: the error interface,
+// error implementations and interfaces embedding error. t may be nil.
+func ImplementsError(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	errorType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	return types.Implements(t, errorType)
+}
+
+// SplitList splits a comma-separated flag value, trimming spaces and dropping
+// empty entries.
+func SplitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// SplitSet returns the entries of a comma-separated flag value as a set (see
+// SplitList).
+func SplitSet(v string) map[string]bool {
+	out := make(map[string]bool)
+	for _, s := range SplitList(v) {
+		out[s] = true
+	}
+	return out
+}
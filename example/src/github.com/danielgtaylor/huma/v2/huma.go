@@ -1,5 +1,19 @@
 package huma
 
+import "context"
+
+type API interface{}
+
+type Operation struct {
+	OperationID string
+	Method      string
+	Path        string
+	Summary     string
+	Tags        []string
+}
+
+func Register[I, O any](_ API, _ Operation, _ func(context.Context, *I) (*O, error)) {}
+
 func Get(_ any, _ string, _ any, _ ...any)     {}
 func Post(_ any, _ string, _ any, _ ...any)    {}
 func Put(_ any, _ string, _ any, _ ...any)     {}
@@ -0,0 +1,22 @@
+// Package pgx is a minimal stub of github.com/jackc/pgx/v5 for analyzer tests.
+package pgx
+
+import "errors"
+
+var ErrNoRows = errors.New("no rows in result set")
+
+type Row interface {
+	Scan(dest ...any) error
+}
+
+type Rows interface {
+	Next() bool
+	Close()
+}
+
+type RowToFunc[T any] func(row Row) (T, error)
+
+func CollectOneRow[T any](rows Rows, fn RowToFunc[T]) (T, error) {
+	var zero T
+	return zero, nil
+}
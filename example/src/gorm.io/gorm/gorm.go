@@ -0,0 +1,20 @@
+// Package gorm is a minimal stub of gorm.io/gorm for analyzer tests.
+package gorm
+
+import "errors"
+
+var ErrRecordNotFound = errors.New("record not found")
+
+type DB struct {
+	Error error
+}
+
+func (db *DB) Where(query any, args ...any) *DB { return db }
+
+func (db *DB) First(dest any, conds ...any) *DB { return db }
+
+func (db *DB) Take(dest any, conds ...any) *DB { return db }
+
+func (db *DB) Find(dest any, conds ...any) *DB { return db }
+
+func (db *DB) Create(value any) *DB { return db }
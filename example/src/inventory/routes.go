@@ -0,0 +1,48 @@
+package userhandler
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+type UserHandler struct{}
+
+func (h *UserHandler) Routes() {
+	Handle(Operation{}.WithPattern("PATCH /users/{userId}"), h.UpdateUser)
+	Handle(Operation{}.WithPattern("GET /users"), ListUsers)
+}
+
+type UpdateUserInput struct {
+	UserID  string `path:"userId"`
+	DryRun  bool   `query:"dryRun"`
+	IfMatch string `header:"If-Match"`
+	Body    UpdateUserBodyInput
+}
+
+type UpdateUserBodyInput struct {
+	Name     string `json:"name"`
+	Email    string `json:"email,omitempty"`
+	Internal string `json:"-"`
+}
+
+type UpdateUserOutput struct {
+	ETag string `header:"ETag"`
+	ID   string `json:"id"`
+}
+
+func (h *UserHandler) UpdateUser(ctx context.Context, in *UpdateUserInput) (*UpdateUserOutput, error) {
+	return nil, nil
+}
+
+type ListUsersInput struct {
+	Cursor string `query:"cursor"`
+}
+
+type ListUsersOutput struct{}
+
+func ListUsers(ctx context.Context, in *ListUsersInput) (*ListUsersOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,7 @@
+module inventoryhuma
+
+go 1.26
+
+require github.com/danielgtaylor/huma/v2 v2.0.0
+
+replace github.com/danielgtaylor/huma/v2 => ../github.com/danielgtaylor/huma/v2
@@ -0,0 +1,43 @@
+package assethandler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type AssetHandler struct{}
+
+func (h *AssetHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-asset",
+		Method:      http.MethodGet,
+		Path:        "/assets/{assetId}",
+	}, h.GetAsset)
+	huma.Get(api, "/assets", h.ListAssets)
+}
+
+type GetAssetInput struct {
+	AssetID string `path:"assetId"`
+}
+
+type GetAssetOutput struct {
+	Body AssetBodyOutput
+}
+
+type AssetBodyOutput struct {
+	ID string `json:"id"`
+}
+
+func (h *AssetHandler) GetAsset(ctx context.Context, in *GetAssetInput) (*GetAssetOutput, error) {
+	return nil, nil
+}
+
+type ListAssetsInput struct{}
+
+type ListAssetsOutput struct{}
+
+func (h *AssetHandler) ListAssets(ctx context.Context, in *ListAssetsInput) (*ListAssetsOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,28 @@
+package lint001attrs
+
+import (
+	"context"
+	"log/slog"
+)
+
+const (
+	keyTenantID = "tenantID"
+	keyTraceID  = "trace_id"
+)
+
+func Bad(ctx context.Context, logger *slog.Logger) {
+	slog.InfoContext(ctx, "msg", "UserID", 1)                       // want `LINT-001: slog key "UserID" must be lowercase_snake_case`
+	slog.Log(ctx, slog.LevelInfo, "msg", "RequestID", "abc")        // want `LINT-001: slog key "RequestID" must be lowercase_snake_case`
+	slog.Info("msg", slog.String("userId", "u"), "HttpStatus", 500) // want `LINT-001: slog key "userId" must be lowercase_snake_case` `LINT-001: slog key "HttpStatus" must be lowercase_snake_case`
+	slog.Info("msg", slog.Int("retryCount", 1))                     // want `LINT-001: slog key "retryCount" must be lowercase_snake_case`
+	slog.Info("msg", slog.Any("Payload", nil))                      // want `LINT-001: slog key "Payload" must be lowercase_snake_case`
+	slog.Info("msg", slog.Attr{Key: "AttrKey"})                     // want `LINT-001: slog key "AttrKey" must be lowercase_snake_case`
+	slog.Info("msg", slog.Group("Request", "Method", "GET"))        // want `LINT-001: slog key "Request" must be lowercase_snake_case` `LINT-001: slog key "Method" must be lowercase_snake_case`
+	slog.Info("msg", keyTenantID, "t")                              // want `LINT-001: slog key "tenantID" must be lowercase_snake_case`
+	slog.Info("msg", keyTraceID, "t")                               // ok
+
+	l := logger.With("SessionID", "s")                                 // want `LINT-001: slog key "SessionID" must be lowercase_snake_case`
+	l = l.WithGroup("HTTP")                                            // want `LINT-001: slog key "HTTP" must be lowercase_snake_case`
+	l.LogAttrs(ctx, slog.LevelInfo, "msg", slog.Bool("isAdmin", true)) // want `LINT-001: slog key "isAdmin" must be lowercase_snake_case`
+	l.InfoContext(ctx, "msg", "user_id", 1, "UserName", "n")           // want `LINT-001: slog key "UserName" must be lowercase_snake_case`
+}
@@ -0,0 +1,18 @@
+package lint003attrs
+
+import (
+	"context"
+	"log/slog"
+)
+
+const keyUserID = "userId"
+
+func Bad(ctx context.Context, logger *slog.Logger) {
+	slog.InfoContext(ctx, "msg", "error", "e")           // want `LINT-003: slog key "error" should use dot notation, e.g. "error.message"`
+	slog.Info("msg", slog.String("userId", "u"))         // want `LINT-003: slog key "userId" should use dot notation, e.g. "user.id"`
+	slog.Info("msg", keyUserID, "u")                     // want `LINT-003: slog key "userId" should use dot notation, e.g. "user.id"`
+	logger.With("sessionId", "s").Info("msg")            // want `LINT-003: slog key "sessionId" should use dot notation, e.g. "session.id"`
+	slog.Info("msg", slog.Group("error", "code", 1))     // ok - group names are not renamed
+	slog.Info("msg", slog.Attr{Key: "error.message"})    // ok
+	slog.Log(ctx, slog.LevelWarn, "msg", "user.id", "u") // ok
+}
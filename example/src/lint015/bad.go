@@ -1,4 +1,4 @@
-package userstore // want `LINT-015: file "bad.go" in store/service/handler package must contain exactly one exported store/service/handler method, found 2`
+package userstore // want `LINT-015: file "bad.go" in store/service/handler/worker package must contain exactly one exported store/service/handler/worker method, found 2`
 
 type UserStore struct{}
 
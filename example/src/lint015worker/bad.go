@@ -0,0 +1,9 @@
+package emailworker // want `LINT-015: file "bad.go" in store/service/handler/worker package must contain exactly one exported store/service/handler/worker method, found 2`
+
+type EmailWorker struct{}
+
+func (w *EmailWorker) Run() {}
+
+func (w *EmailWorker) HandleBounce() {}
+
+func (w *EmailWorker) Name() string { return "email" } // ok - not a Run/Handle* method
@@ -0,0 +1,7 @@
+package emailworker
+
+type CleanupWorker struct{}
+
+func (w *CleanupWorker) Run() {} // ok - worker.go is exempt
+
+func (w *CleanupWorker) HandleExpired() {}
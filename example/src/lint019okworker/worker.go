@@ -0,0 +1,3 @@
+package emailworker
+
+var FxModule = struct{}{}
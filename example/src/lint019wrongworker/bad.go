@@ -0,0 +1,3 @@
+package emailworker
+
+var FxModule = struct{}{} // want `LINT-019: FxModule in package "emailworker" must be declared in file "worker\.go"`
@@ -0,0 +1,5 @@
+package authhandler
+
+import "net/http"
+
+func (h *AuthHandler) GetByID(w http.ResponseWriter, r *http.Request) {}
@@ -0,0 +1,3 @@
+module lint031huma
+
+go 1.26
@@ -0,0 +1,37 @@
+package objecthandler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type ObjectHandler struct{}
+
+func (h *ObjectHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-object",
+		Method:      http.MethodGet,
+		Path:        "/objects/{object_id}", // want `LINT-031: huma path param "object_id" must be lowerCamelCase`
+	}, h.GetObject)
+	huma.Register(api, huma.Operation{
+		OperationID: "get-object-file",
+		Method:      http.MethodGet,
+		Path:        "/objects/{objectId}/files/{FilePath...}", // want `LINT-031: huma path param "FilePath" must be lowerCamelCase`
+	}, h.GetObject)
+	huma.Get(api, "/objects/{ObjectID}/raw", h.GetObject) // want `LINT-031: huma path param "ObjectID" must be lowerCamelCase`
+	huma.Register(api, huma.Operation{
+		OperationID: "list-objects",
+		Method:      http.MethodGet,
+		Path:        "/objects/{objectId}/versions",
+	}, h.GetObject)
+}
+
+type GetObjectInput struct{}
+
+type GetObjectOutput struct{}
+
+func (h *ObjectHandler) GetObject(ctx context.Context, in *GetObjectInput) (*GetObjectOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,11 @@
+package emailworker
+
+type EmailWorker struct{}
+
+func New() *EmailWorker { // want `LINT-032: package "emailworker" must expose only one constructor matching New\*; found 2`
+	return &EmailWorker{}
+}
+
+func NewEmailWorker() *EmailWorker { // want `LINT-032: constructor "NewEmailWorker" in package "emailworker" must be named "New"`
+	return &EmailWorker{}
+}
@@ -0,0 +1,13 @@
+package main // want package:"layerUsage"
+
+import (
+	"context"
+
+	"lint033/userservice"
+	"lint033/userstore"
+)
+
+func main() { // want `LINT-033: interface "lint033/types\.AuditService" has no implementation in any store/service/worker package` `LINT-033: method "DeleteByID" of interface "lint033/types\.UserStore" is never called outside its implementation`
+	svc := userservice.New(&userstore.UserStore{})
+	_, _ = svc.Get(context.Background(), "id")
+}
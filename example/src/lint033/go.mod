@@ -0,0 +1,3 @@
+module lint033
+
+go 1.26
@@ -0,0 +1,19 @@
+package types
+
+import "context"
+
+type User struct{}
+
+type UserStore interface {
+	GetByID(ctx context.Context, id string) (*User, error)
+
+	DeleteByID(ctx context.Context, id string) error
+}
+
+type UserService interface {
+	Get(ctx context.Context, id string) (*User, error)
+}
+
+type AuditService interface {
+	Record(ctx context.Context, action string) error
+}
@@ -0,0 +1,21 @@
+package userservice // want package:"layerUsage"
+
+import (
+	"context"
+
+	"lint033/types"
+)
+
+var _ types.UserService = (*UserService)(nil)
+
+type UserService struct {
+	store types.UserStore
+}
+
+func New(store types.UserStore) *UserService {
+	return &UserService{store: store}
+}
+
+func (s *UserService) Get(ctx context.Context, id string) (*types.User, error) {
+	return s.store.GetByID(ctx, id)
+}
@@ -0,0 +1,22 @@
+package userstore // want package:"layerUsage"
+
+import (
+	"context"
+
+	"lint033/types"
+)
+
+var _ types.UserStore = (*UserStore)(nil)
+
+type UserStore struct{}
+
+type CacheStore struct{} // want `LINT-033: "CacheStore" implements no types\.\*Store/\*Service/\*Worker interface`
+
+func (s *UserStore) GetByID(_ context.Context, _ string) (*types.User, error) {
+	return nil, nil
+}
+
+func (s *UserStore) DeleteByID(ctx context.Context, id string) error {
+	_, err := s.GetByID(ctx, id) // ok - calls inside the implementation do not count
+	return err
+}
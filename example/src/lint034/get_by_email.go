@@ -0,0 +1,7 @@
+package userstore
+
+import "strings"
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(email)
+}
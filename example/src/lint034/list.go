@@ -0,0 +1,15 @@
+package userstore
+
+import "context"
+
+func (s *UserStore) ListUsers(_ context.Context) ([]*User, error) { // ok - "users" is de-duplicated
+	return nil, nil
+}
+
+func (s *UserStore) DeleteUser(_ context.Context) error { // want `LINT-034: store method "DeleteUser" on "UserStore" must be in file "delete\.go"`
+	return nil
+}
+
+func (s *UserStore) ListByUserID(_ context.Context) ([]*User, error) { // want `LINT-034: store method "ListByUserID" on "UserStore" must be in file "list_by_user_id\.go"`
+	return nil, nil
+}
@@ -0,0 +1,10 @@
+package userstore
+
+type User struct{}
+
+type UserStore struct{}
+
+// GetByEmail returns the user with the given email.
+func (s *UserStore) GetByEmail(email string) (*User, error) { // want `LINT-034: store method "GetByEmail" on "UserStore" must be in file "get_by_email\.go"`
+	return nil, nil
+}
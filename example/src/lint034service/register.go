@@ -0,0 +1,15 @@
+package userservice
+
+type UserService struct{}
+
+func (s *UserService) Register() error { // ok
+	return nil
+}
+
+func (s *UserService) SendWelcomeEmail() error { // want `LINT-034: service method "SendWelcomeEmail" on "UserService" must be in file "send_welcome_email\.go"`
+	return nil
+}
+
+func (s *UserService) validate() error { // ok - unexported
+	return nil
+}
@@ -0,0 +1,4 @@
+package emailworker
+
+// EmailWorker is an exported worker struct without interface assertion in worker.go
+type EmailWorker struct{} // want `LINT-035: worker struct "EmailWorker" missing compile-time interface assertion in worker\.go`
@@ -0,0 +1,13 @@
+package emailworker
+
+type Worker interface {
+	Run() error
+}
+
+var _ Worker = (*EmailWorker)(nil)
+
+type EmailWorker struct{}
+
+func (w *EmailWorker) Run() error {
+	return nil
+}
@@ -0,0 +1,3 @@
+module lint036
+
+go 1.26
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"lint036/model"     // want `LINT-036: types package must not import layer package "lint036/model"`
+	"lint036/userstore" // want `LINT-036: types package must not import layer package "lint036/userstore"`
+)
+
+var defaultStore = &userstore.UserStore{} // want `LINT-036: types package must not declare package-level variable "defaultStore"`
+
+var ErrNotReady = fmt.Errorf("not ready") // ok - error sentinel
+
+var ErrCount = 3 // want `LINT-036: types package must not declare package-level variable "ErrCount"`
+
+func init() { // want `LINT-036: types package must not declare init functions`
+	_ = defaultStore
+}
+
+type User struct {
+	Model *model.User
+	Name  string
+}
+
+func (u User) DisplayName() string { // ok - trivial method
+	return fmt.Sprintf("user %s", u.Name)
+}
+
+func (u User) Save() error {
+	return os.WriteFile("user.txt", []byte(u.Name), 0o600) // want `LINT-036: function "Save" in types package must not perform I/O \(calls os\.WriteFile\)`
+}
+
+func PrintUser(u User) {
+	fmt.Println(u.Name) // want `LINT-036: function "PrintUser" in types package must not perform I/O \(calls fmt\.Println\)`
+}
+
+func StatusMessage(code int) string { // ok - pure net/http helper
+	return fmt.Sprintf("%d %s", code, http.StatusText(code))
+}
+
+func (u User) Fetch(client *http.Client) error {
+	req, err := http.NewRequest(http.MethodGet, "/users/"+u.Name, nil)
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req) // want `LINT-036: function "Fetch" in types package must not perform I/O \(calls http\.Client\.Do\)`
+	return err
+}
@@ -0,0 +1,3 @@
+package userstore
+
+type UserStore struct{}
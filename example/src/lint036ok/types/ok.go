@@ -0,0 +1,42 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+type UserStatus string
+
+const (
+	UserStatusActive   UserStatus = "active"
+	UserStatusDisabled UserStatus = "disabled"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+var _ error = (*ValidationError)(nil)
+
+type ValidationError struct {
+	Field string
+}
+
+type UserStore interface {
+	Get(ctx context.Context, id string) (*User, error)
+}
+
+type User struct {
+	ID     string
+	Status UserStatus
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid " + e.Field
+}
+
+func NewValidationError(field string) *ValidationError {
+	return &ValidationError{Field: field}
+}
+
+func (s UserStatus) IsActive() bool {
+	return s == UserStatusActive
+}
@@ -0,0 +1,33 @@
+package types
+
+import "context"
+
+type User struct{}
+
+type UserStore interface {
+	Get(ctx context.Context, id string) (*User, error) // ok
+
+	GetByEmail(email string) (*User, error) // want `LINT-037: method "GetByEmail" of "UserStore" must take context\.Context as first parameter`
+
+	GetName(ctx context.Context, id string) (string, error) // want `LINT-037: method "GetName" of "UserStore" must return a single pointer and an error`
+
+	List(ctx context.Context) ([]*User, error) // ok
+
+	ListActive(ctx context.Context) (*User, error) // want `LINT-037: method "ListActive" of "UserStore" must return a slice and an error`
+
+	Create(ctx context.Context, u *User) (*User, error) // ok
+
+	Update(ctx context.Context, u *User) (*User, int, error) // want `LINT-037: method "Update" of "UserStore" must return at most one value and an error`
+
+	Delete(ctx context.Context, id string) // want `LINT-037: method "Delete" of "UserStore" must return error as last result`
+
+	Getaway(ctx context.Context) error // ok - "Get" is not followed by an upper-case letter
+}
+
+type UserService interface {
+	Register(ctx context.Context, email string) (*User, error) // ok - no verb convention
+}
+
+type Clock interface {
+	Now() int64 // ok - not a Store/Service interface
+}
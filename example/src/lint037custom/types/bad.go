@@ -0,0 +1,11 @@
+package types
+
+import "context"
+
+type User struct{}
+
+type UserStore interface {
+	Find(ctx context.Context, id string) ([]*User, error) // want `LINT-037: method "Find" of "UserStore" must return a single pointer and an error`
+
+	Get(ctx context.Context, id string) (string, error) // ok - Get is not configured
+}
@@ -0,0 +1,26 @@
+package lint038
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+)
+
+const keyUserID = "userId"
+
+func Bad(id string, n int) {
+	slog.Info("msg", "user.id", id)                    // ok
+	slog.Info("msg", "user.id", n)                     // want `LINT-038: value of slog key "user\.id" must be of type string according to the log schema, got int`
+	slog.Info("msg", slog.Int("user.id", n))           // want `LINT-038: value of slog key "user\.id" must be of type string according to the log schema, got int`
+	slog.Info("msg", "duration_ms", 12)                // ok
+	slog.Info("msg", "duration_ms", 1.5)               // want `LINT-038: value of slog key "duration_ms" must be of type int according to the log schema, got float`
+	slog.Info("msg", "elapsed", time.Second)           // ok
+	slog.Info("msg", "elapsed", 3)                     // want `LINT-038: value of slog key "elapsed" must be of type duration according to the log schema, got int`
+	slog.Info("msg", "error", errors.New("boom"))      // ok
+	slog.Info("msg", "payload", map[string]int{})      // ok - any
+	slog.Info("msg", "userId", id)                     // want `LINT-038: slog key "userId" is deprecated, use "user\.id"`
+	slog.Info("msg", keyUserID, id)                    // want `LINT-038: slog key "userId" is deprecated, use "user\.id"`
+	slog.Info("msg", "legacy_flag", true)              // want `LINT-038: slog key "legacy_flag" is deprecated`
+	slog.Info("msg", "tenant", "t")                    // want `LINT-038: slog key "tenant" is not declared in the log schema`
+	slog.Info("msg", slog.Group("request", "id", "r")) // want `LINT-038: slog key "id" is not declared in the log schema`
+}
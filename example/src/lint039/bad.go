@@ -0,0 +1,34 @@
+package lint039
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+const greeting = "hello"
+
+type user struct {
+	ID     string
+	UserID string
+}
+
+func Bad(ctx context.Context, logger *slog.Logger, id string, u user, count int) {
+	err := errors.New("boom")
+
+	slog.Info(fmt.Sprintf("user %s created", id))                            // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	slog.Error("failed: " + err.Error())                                     // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	logger.InfoContext(ctx, fmt.Sprintf("loaded %d items", count), "id", id) // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	slog.Warn("user " + u.UserID + " has id " + u.ID)                        // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	slog.Info(fmt.Sprintf("progress %d%%", count))                           // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+
+	msg := "dynamic"
+	slog.Info(msg)                                                   // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	slog.Info(fmt.Sprintf("%[1]s %[1]s", id))                        // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+	logger.LogAttrs(ctx, slog.LevelInfo, "count "+fmt.Sprint(count)) // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+
+	slog.Info("user created", "user_id", id) // ok
+	slog.Info(greeting)                      // ok
+	slog.Info("hello " + "world")            // ok
+}
@@ -0,0 +1,10 @@
+package lint039
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+func SprintfOnly(id string) {
+	slog.Info(fmt.Sprintf("user %s deleted", id)) // want `LINT-039: slog message must be a constant string, pass dynamic values as attributes`
+}
@@ -0,0 +1,28 @@
+package lint040
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+func Bad(ctx context.Context, logger *slog.Logger) {
+	err := errors.New("boom")
+
+	slog.Error("save failed", "err", err)                                     // want `LINT-040: error value must be logged under key "error", got "err"`
+	slog.Error("save failed", "error", err.Error())                           // want `LINT-040: log the error value under "error" instead of calling Error\(\)`
+	slog.Error("save failed", slog.String("error", err.Error()))              // want `LINT-040: log the error value under "error" instead of calling Error\(\)`
+	slog.Error("save failed", err)                                            // want `LINT-040: error value must be logged under key "error"` `LINT-040: error-level log call carries no error value under key "error"`
+	slog.Error("save failed", "user_id", 1)                                   // want `LINT-040: error-level log call carries no error value under key "error"`
+	logger.ErrorContext(ctx, "save failed")                                   // want `LINT-040: error-level log call carries no error value under key "error"`
+	logger.Warn("retrying", slog.Any("cause", err))                           // want `LINT-040: error value must be logged under key "error", got "cause"`
+	logger.Log(ctx, slog.LevelError, "save failed")                           // want `LINT-040: error-level log call carries no error value under key "error"`
+	logger.LogAttrs(ctx, slog.LevelWarn, "retrying", slog.Any("reason", err)) // want `LINT-040: error value must be logged under key "error", got "reason"`
+
+	slog.Error("save failed", "error", err)                                      // ok
+	slog.Error("save failed", slog.Any("error", err))                            // ok
+	logger.Warn("slow request", "duration_ms", 1200)                             // ok
+	slog.Info("save failed", "err", err)                                         // ok: below warn level
+	logger.Log(ctx, slog.LevelInfo, "done")                                      // ok
+	logger.LogAttrs(ctx, slog.LevelError, "save failed", slog.Any("error", err)) // ok
+}
@@ -0,0 +1,3 @@
+module lint040
+
+go 1.26
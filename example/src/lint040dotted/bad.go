@@ -0,0 +1,13 @@
+package lint040dotted
+
+import (
+	"errors"
+	"log/slog"
+)
+
+func Bad() {
+	err := errors.New("boom")
+
+	slog.Error("save failed", "error", err)         // want `LINT-040: error value must be logged under key "error.message", got "error"`
+	slog.Error("save failed", "error.message", err) // ok
+}
@@ -0,0 +1,67 @@
+package lint041
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+type User struct {
+	ID       string
+	Email    string
+	Password string
+	APIKey   string `sensitive:"true"`
+}
+
+type Session struct {
+	Token string
+}
+
+// Profile hides its fields behind LogValue.
+type Profile struct {
+	Email string
+}
+
+func (p Profile) LogValue() slog.Value { return slog.StringValue("profile") }
+
+type Event struct {
+	Owner User
+}
+
+func userEmail(u *User) string {
+	return u.Email
+}
+
+func contact(u *User) string {
+	e := userEmail(u)
+	return e
+}
+
+func hash(s string) string { return fmt.Sprint(len(s)) }
+
+func Bad(ctx context.Context, logger *slog.Logger, u *User, s Session, ev Event) {
+	slog.Info("login", "user_email", u.Email)             // want `LINT-041: value logged under "user_email" is derived from sensitive field User.Email`
+	slog.Info("login", "password", "***")                 // want `LINT-041: slog key "password" may contain sensitive data`
+	slog.Info("login", "access_token", 1)                 // want `LINT-041: slog key "access_token" may contain sensitive data`
+	slog.Info("login", slog.String("apiToken", "***"))    // want `LINT-041: slog key "apiToken" may contain sensitive data`
+	slog.Info("login", "userPassword", "***")             // want `LINT-041: slog key "userPassword" may contain sensitive data`
+	slog.Info("login", "AccessToken", 1)                  // want `LINT-041: slog key "AccessToken" may contain sensitive data`
+	slog.Info("login", slog.String("key", u.APIKey))      // want `LINT-041: value logged under "key" is derived from sensitive field User.APIKey`
+	logger.InfoContext(ctx, "login", slog.Any("user", u)) // want `LINT-041: value logged under "user" is derived from sensitive field User.Email`
+	slog.Info("event", "event", ev)                       // want `LINT-041: value logged under "event" is derived from sensitive field User.Email`
+	slog.Info("login " + s.Token)                         // want `LINT-041: slog message is derived from sensitive field Session.Token`
+
+	pwd := u.Password
+	masked := "pwd=" + pwd
+	slog.Warn("login", "credentials", masked) // want `LINT-041: value logged under "credentials" is derived from sensitive field User.Password`
+
+	var addr = contact(u)
+	slog.Info("contact", "addr", addr)                            // want `LINT-041: value logged under "addr" is derived from sensitive field User.Email`
+	slog.Info("contact", "detail", fmt.Sprintf("to %s", u.Email)) // want `LINT-041: value logged under "detail" is derived from sensitive field User.Email`
+	logger.With("who", []byte(u.Email)).Info("contact")           // want `LINT-041: value logged under "who" is derived from sensitive field User.Email`
+
+	slog.Info("login", "user_id", u.ID)             // ok
+	slog.Info("login", "hash", hash(u.Password))    // ok: sanitized by a function call
+	slog.Info("login", "profile", Profile{})        // ok: LogValuer
+	slog.Info("login", "token_count", len(s.Token)) // want `LINT-041: slog key "token_count" may contain sensitive data`
+}
@@ -0,0 +1,22 @@
+package lint041custom
+
+import "log/slog"
+
+type User struct {
+	Name        string
+	Password    string
+	PhoneNumber string
+}
+
+type Contact struct {
+	PhoneNumber string
+}
+
+func Bad(u User, c Contact) {
+	slog.Info("call", "phone", u.PhoneNumber) // want `LINT-041: value logged under "phone" is derived from sensitive field User.PhoneNumber`
+	slog.Info("call", "ssn_hash", 1)          // want `LINT-041: slog key "ssn_hash" may contain sensitive data`
+
+	slog.Info("call", "contact_phone", c.PhoneNumber) // ok: only User.PhoneNumber is configured
+	slog.Info("call", "pwd", u.Password)              // ok: not configured
+	slog.Info("call", "password", u.Name)             // ok: not configured
+}
@@ -0,0 +1,20 @@
+package lint042handler
+
+import (
+	"context"
+	"log/slog"
+)
+
+func loggerFrom(ctx context.Context) *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+type UserHandler struct {
+	logger *slog.Logger
+}
+
+func (h *UserHandler) Get(ctx context.Context) {
+	h.logger.Info("loading user") // want `LINT-042: logger from a struct field in lint042handler package, use a context-derived logger`
+
+	loggerFrom(ctx).Info("loading user") // ok
+}
@@ -0,0 +1,7 @@
+package lint042other
+
+import "log/slog"
+
+func Run() {
+	slog.Info("starting") // ok: not a layer package
+}
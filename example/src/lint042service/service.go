@@ -0,0 +1,36 @@
+package lint042service
+
+import (
+	"context"
+	"log/slog"
+)
+
+var logger = slog.Default() // package-level variable
+
+type contextKey struct{}
+
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.New(slog.DiscardHandler)
+}
+
+type UserService struct {
+	logger *slog.Logger
+}
+
+func (s *UserService) Create(ctx context.Context, l *slog.Logger) {
+	slog.Info("creating user")              // want `LINT-042: package-level slog.Info in lint042service package, use an injected \*slog.Logger field or a context-derived logger`
+	slog.ErrorContext(ctx, "creating user") // want `LINT-042: package-level slog.ErrorContext in lint042service package, use an injected \*slog.Logger field or a context-derived logger`
+	slog.Default().Info("creating user")    // want `LINT-042: package-level slog.Default in lint042service package, use an injected \*slog.Logger field or a context-derived logger`
+	logger.Info("creating user")            // want `LINT-042: global logger in lint042service package, use an injected \*slog.Logger field or a context-derived logger`
+	scoped := logger.With("tenant_id", "t") // ok: reported where it logs
+	scoped.Info("creating user")            // want `LINT-042: global logger in lint042service package, use an injected \*slog.Logger field or a context-derived logger`
+
+	s.logger.Info("creating user")                    // ok
+	s.logger.With("user_id", 1).Info("creating user") // ok
+	loggerFrom(ctx).InfoContext(ctx, "creating user") // ok
+	l.Info("creating user")                           // ok
+	_ = slog.String("user_id", "u")                   // ok
+}
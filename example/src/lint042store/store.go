@@ -0,0 +1,21 @@
+package lint042store
+
+import (
+	"context"
+	"log/slog"
+)
+
+func loggerFrom(ctx context.Context) *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+type UserStore struct {
+	logger *slog.Logger
+}
+
+func (s *UserStore) Get(ctx context.Context) {
+	log := loggerFrom(ctx).With("table", "users")
+	log.Info("loading user") // want `LINT-042: context-derived logger in lint042store package, use an injected \*slog.Logger field`
+
+	s.logger.Info("loading user") // ok
+}
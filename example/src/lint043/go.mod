@@ -0,0 +1,3 @@
+module lint043
+
+go 1.26
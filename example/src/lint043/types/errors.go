@@ -0,0 +1,10 @@
+package types
+
+import "errors"
+
+var ErrUserNotFound = errors.New("user not found")
+
+type User struct {
+	ID    string
+	Email string
+}
@@ -0,0 +1,16 @@
+package userhandler
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type UserHandler struct{}
+
+func (h *UserHandler) Get(err error) int {
+	if errors.Is(err, pgx.ErrNoRows) { // want `LINT-043: errors.Is check against pgx.ErrNoRows in userhandler package leaks the store layer, check a types.Err\* sentinel instead`
+		return 404
+	}
+	return 200
+}
@@ -0,0 +1,25 @@
+package userservice
+
+import (
+	"database/sql"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"lint043/types"
+)
+
+type UserService struct{}
+
+func (s *UserService) Get(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) { // want `LINT-043: errors.Is check against gorm.ErrRecordNotFound in userservice package leaks the store layer, check a types.Err\* sentinel instead`
+		return err
+	}
+	if err == sql.ErrNoRows { // want `LINT-043: == check against sql.ErrNoRows in userservice package leaks the store layer, check a types.Err\* sentinel instead`
+		return err
+	}
+	if errors.Is(err, types.ErrUserNotFound) { // ok
+		return err
+	}
+	return nil
+}
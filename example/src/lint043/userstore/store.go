@@ -0,0 +1,96 @@
+package userstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+
+	"lint043/types"
+)
+
+type UserStore struct {
+	db   *gorm.DB
+	sql  *sql.DB
+	rows pgx.Rows
+}
+
+func (s *UserStore) GetByID(ctx context.Context, id string) (*types.User, error) {
+	var u types.User
+	err := s.db.Where("id = ?", id).First(&u).Error
+	return nil, err // want `LINT-043: not-found error from gorm First escapes GetByID without translation to a types.Err\* sentinel`
+}
+
+func (s *UserStore) GetByEmail(ctx context.Context, email string) (*types.User, error) {
+	var u types.User
+	if err := s.db.Take(&u, "email = ?", email).Error; err != nil {
+		return nil, fmt.Errorf("get user by email: %w", err) // want `LINT-043: not-found error from gorm Take escapes GetByEmail without translation to a types.Err\* sentinel`
+	}
+	return &u, nil
+}
+
+func (s *UserStore) GetDirect(ctx context.Context, id string) error {
+	var u types.User
+	return s.db.First(&u, id).Error // want `LINT-043: not-found error from gorm First escapes GetDirect without translation to a types.Err\* sentinel`
+}
+
+func (s *UserStore) GetEmail(ctx context.Context, id string) (string, error) {
+	var email string
+	err := s.sql.QueryRowContext(ctx, "SELECT email FROM users WHERE id = $1", id).Scan(&email)
+	wrapped := fmt.Errorf("get email: %w", err)
+	return "", wrapped // want `LINT-043: not-found error from sql Row.Scan escapes GetEmail without translation to a types.Err\* sentinel`
+}
+
+func (s *UserStore) Collect(ctx context.Context) (*types.User, error) {
+	u, err := pgx.CollectOneRow(s.rows, func(row pgx.Row) (types.User, error) {
+		var u types.User
+		return u, row.Scan(&u.ID)
+	})
+	if err != nil {
+		return nil, err // want `LINT-043: not-found error from pgx CollectOneRow escapes Collect without translation to a types.Err\* sentinel`
+	}
+	return &u, nil
+}
+
+func (s *UserStore) Get(ctx context.Context, id string) (*types.User, error) {
+	var u types.User
+	err := s.db.First(&u, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err) // ok: not-found is translated above
+	}
+	return &u, nil
+}
+
+func (s *UserStore) Lookup(ctx context.Context, id string) (string, error) {
+	var email string
+	err := s.sql.QueryRowContext(ctx, "SELECT email FROM users WHERE id = $1", id).Scan(&email)
+	switch {
+	case err == sql.ErrNoRows:
+		return "", types.ErrUserNotFound
+	case err != nil:
+		return "", err // ok: not-found is translated above
+	}
+	return email, nil
+}
+
+func (s *UserStore) List(ctx context.Context) ([]types.User, error) {
+	var users []types.User
+	err := s.db.Find(&users).Error
+	return users, err // ok: Find does not report missing rows
+}
+
+func (s *UserStore) Create(ctx context.Context, u *types.User) error {
+	return s.db.Create(u).Error // ok
+}
+
+func (s *UserStore) Swallowed(ctx context.Context, id string) error {
+	var u types.User
+	err := s.db.First(&u, id).Error
+	return fmt.Errorf("get user: %v", err) // ok: the sentinel is not wrapped
+}
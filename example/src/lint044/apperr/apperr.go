@@ -0,0 +1,7 @@
+package apperr
+
+import "errors"
+
+func New(msg string, status int) error {
+	return errors.New(msg)
+}
@@ -0,0 +1,5 @@
+package types // want package:"errors"
+
+import "errors"
+
+var ErrPaymentFailed = errors.New("user not found")
@@ -0,0 +1,13 @@
+package main // want package:"errors"
+
+import (
+	"fmt"
+
+	billingtypes "lint044/billing/types"
+	"lint044/userstore"
+)
+
+func main() { // want `LINT-044: error message "user not found" is used by both "lint044/billing/types.ErrPaymentFailed" and "lint044/types.ErrDuplicate"` `LINT-044: error message "user not found" is used by both "lint044/billing/types.ErrPaymentFailed" and "lint044/types.ErrUserNotFound"` `LINT-044: error sentinel "lint044/types.ErrUnused" is never referenced in the module` `LINT-044: error sentinel "lint044/types.ErrWrapped" is never referenced in the module`
+	s := &userstore.UserStore{}
+	fmt.Println(s.Check(billingtypes.ErrPaymentFailed))
+}
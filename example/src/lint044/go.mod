@@ -0,0 +1,3 @@
+module lint044
+
+go 1.26
@@ -0,0 +1,18 @@
+package types // want package:"errors"
+
+import (
+	"errors"
+	"fmt"
+
+	"lint044/apperr"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrInvalidEmail = errors.New("Invalid email.") // want `LINT-044: message of "ErrInvalidEmail" must be lowercase without trailing punctuation`
+	ErrJWTExpired   = errors.New("JWT expired")
+	ErrDuplicate    = errors.New("user not found") // want `LINT-044: error message "user not found" of "ErrDuplicate" is already used by "ErrUserNotFound"`
+	ErrConflict     = apperr.New("conflict", 409)
+	ErrWrapped      = fmt.Errorf("wrapped") // want `LINT-044: "ErrWrapped" must be built with errors.New or a configured domain-error constructor`
+	ErrUnused       = errors.New("unused")
+)
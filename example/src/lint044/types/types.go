@@ -0,0 +1,28 @@
+package types
+
+import (
+	"context"
+	"errors"
+)
+
+type User struct {
+	ID string
+}
+
+type UserStore interface {
+	GetByID(ctx context.Context, id string) (*User, error)
+}
+
+type OrderStore interface { // want `LINT-044: store interface "OrderStore" has get methods but no "ErrOrderNotFound" sentinel in errors.go`
+	GetOrder(ctx context.Context, id string) (*User, error)
+}
+
+type AuditStore interface {
+	Record(ctx context.Context, event string) error
+}
+
+type InvoiceStore interface { // want `LINT-044: store interface "InvoiceStore" has get methods but no "ErrInvoiceNotFound" sentinel in errors.go`
+	GetInvoice(ctx context.Context, id string) (*User, error)
+}
+
+var ErrInvoiceNotFound = errors.New("invoice not found")
@@ -0,0 +1,19 @@
+package userstore // want package:"errors"
+
+import (
+	"errors"
+
+	"lint044/types"
+)
+
+type UserStore struct{}
+
+func (s *UserStore) Check(err error) error {
+	switch {
+	case errors.Is(err, types.ErrUserNotFound), errors.Is(err, types.ErrDuplicate):
+		return types.ErrUserNotFound
+	case errors.Is(err, types.ErrInvalidEmail), errors.Is(err, types.ErrJWTExpired):
+		return types.ErrConflict
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package apihandler // want package:"errorMapping"
+
+import (
+	"net/http"
+
+	billingtypes "lint045/billing/types"
+	"lint045/types"
+)
+
+var statusByError = map[error]int{
+	types.ErrUserNotFound:         http.StatusNotFound,
+	billingtypes.ErrPaymentFailed: http.StatusPaymentRequired,
+	types.ErrLegacy:               http.StatusGone, // want `LINT-045: mapper entry types.ErrLegacy is not a sentinel declared in types/errors.go`
+}
+
+func Status(err error) int {
+	return statusByError[err]
+}
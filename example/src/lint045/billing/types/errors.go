@@ -0,0 +1,5 @@
+package types // want package:"errorMapping"
+
+import "errors"
+
+var ErrPaymentFailed = errors.New("payment failed")
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	billingtypes "lint045/billing/types"
+	"lint045/handler"
+)
+
+func main() { // want `LINT-045: sentinel "lint045/billing/types.ErrPaymentFailed" is not handled by handler.errorStatus` `LINT-045: sentinel "lint045/types.ErrForbidden" is not handled by handler.errorStatus`
+	fmt.Println(handler.Status(billingtypes.ErrPaymentFailed))
+}
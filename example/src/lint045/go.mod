@@ -0,0 +1,3 @@
+module lint045
+
+go 1.26
@@ -0,0 +1,24 @@
+package handler // want package:"errorMapping"
+
+import (
+	"errors"
+	"net/http"
+
+	"lint045/types"
+)
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, types.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, types.ErrEmailTaken):
+		return http.StatusConflict
+	case errors.Is(err, types.ErrLegacy): // want `LINT-045: mapper entry types.ErrLegacy is not a sentinel declared in types/errors.go`
+		return http.StatusGone
+	}
+	return http.StatusInternalServerError
+}
+
+func Status(err error) int {
+	return errorStatus(err)
+}
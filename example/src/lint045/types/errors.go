@@ -0,0 +1,9 @@
+package types // want package:"errorMapping"
+
+import "errors"
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrEmailTaken   = errors.New("email taken")
+	ErrForbidden    = errors.New("forbidden")
+)
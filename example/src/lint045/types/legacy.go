@@ -0,0 +1,5 @@
+package types
+
+import "errors"
+
+var ErrLegacy = errors.New("legacy")
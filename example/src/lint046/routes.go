@@ -0,0 +1,72 @@
+package objecthandler
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+func Register[I, O any](op Operation) {}
+
+type ObjectHandler struct{}
+
+func (h *ObjectHandler) Routes() {
+	Handle(Operation{}.WithPattern("GET /api/objects/{objectId}"), h.GetObject)                                // want `LINT-046: path param "objectId" of route "/api/objects/\{objectId\}" has no matching path:"objectId" field in GetObjectInput \(found path:"objectID", which differs only in case\)`
+	Handle(Operation{}.WithPattern("PUT /api/objects/{objectId}/tenants/{tenantId}"), h.UpdateObject)          // want `LINT-046: path param "tenantId" of route "/api/objects/\{objectId\}/tenants/\{tenantId\}" has no matching path:"tenantId" field in UpdateObjectInput`
+	Register[DeleteObjectInput, DeleteObjectOutput](Operation{}.WithPattern("DELETE /api/objects/{objectId}")) // want `LINT-046: path param "objectId" of route "/api/objects/\{objectId\}" has no matching path:"objectId" field in DeleteObjectInput`
+	Handle(Operation{}.WithPattern("GET /api/files/{path...}"), h.GetFile)
+	Handle(Operation{}.WithPattern("GET /api/objects/{objectId}/raw"), func(ctx context.Context, in *GetObjectRawInput) (*GetObjectRawOutput, error) {
+		return nil, nil
+	})
+	Operation{}.WithPattern("GET /api/health/{component}") // ok: no Input resolved
+}
+
+type GetObjectInput struct {
+	ObjectID string `path:"objectID"` // want `LINT-046: field GetObjectInput.ObjectID has path tag "objectID" that does not appear in route "/api/objects/\{objectId\}"`
+}
+
+type GetObjectOutput struct{}
+
+func (h *ObjectHandler) GetObject(ctx context.Context, in *GetObjectInput) (*GetObjectOutput, error) {
+	return nil, nil
+}
+
+type ObjectRef struct {
+	ObjectID string `path:"objectId"`
+}
+
+type UpdateObjectInput struct {
+	ObjectRef
+	Version int    `query:"version"`
+	Locale  string `path:"locale"` // want `LINT-046: field UpdateObjectInput.Locale has path tag "locale" that does not appear in route "/api/objects/\{objectId\}/tenants/\{tenantId\}"`
+}
+
+type UpdateObjectOutput struct{}
+
+func (h *ObjectHandler) UpdateObject(ctx context.Context, in *UpdateObjectInput) (*UpdateObjectOutput, error) {
+	return nil, nil
+}
+
+type DeleteObjectInput struct {
+	ID string `path:"id"` // want `LINT-046: field DeleteObjectInput.ID has path tag "id" that does not appear in route "/api/objects/\{objectId\}"`
+}
+
+type DeleteObjectOutput struct{}
+
+type GetFileInput struct {
+	Path string `path:"path"`
+}
+
+type GetFileOutput struct{}
+
+func (h *ObjectHandler) GetFile(ctx context.Context, in *GetFileInput) (*GetFileOutput, error) {
+	return nil, nil
+}
+
+type GetObjectRawInput struct {
+	ObjectID string `path:"objectId"`
+}
+
+type GetObjectRawOutput struct{}
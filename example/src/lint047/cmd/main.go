@@ -0,0 +1,7 @@
+package main
+
+import "lint047/userhandler"
+
+func main() { // want `LINT-047: OpenAPI operation "DELETE /users/\{id\}" has no handler`
+	(&userhandler.UserHandler{}).Routes()
+}
@@ -0,0 +1,3 @@
+module lint047
+
+go 1.26
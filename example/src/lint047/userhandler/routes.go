@@ -0,0 +1,88 @@
+package userhandler // want package:"routes"
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+type UserHandler struct{}
+
+func (h *UserHandler) Routes() {
+	Handle(Operation{}.WithPattern("GET /users"), h.ListUsers)        // want `LINT-047: property "next_cursor" of the OpenAPI response schema of "GET /users" has no json field in ListUsersOutput`
+	Handle(Operation{}.WithPattern("POST /users"), h.CreateUser)      // want `LINT-047: property "password" of the OpenAPI request schema of "POST /users" has no json field in CreateUserBodyInput`
+	Handle(Operation{}.WithPattern("GET /users/{userId}"), h.GetUser) // want `LINT-047: path param "userId" of route "GET /users/\{userId\}" is named "id" in the OpenAPI document`
+	Handle(Operation{}.WithPattern("PUT /users/{id}/avatar"), h.UpdateAvatar)
+	Handle(Operation{}.WithPattern("PATCH /users/{id}"), h.UpdateUser) // want `LINT-047: route "PATCH /users/\{id\}" is not documented in openapi.yaml`
+}
+
+type ListUsersInput struct {
+	Cursor string `query:"cursor"`
+}
+
+type ListUsersOutput struct {
+	Users      []User `json:"users"`
+	NextCursor string `json:"nextCursor"` // want `LINT-047: json field "nextCursor" of ListUsersOutput is not a property of the OpenAPI response schema of "GET /users"`
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, in *ListUsersInput) (*ListUsersOutput, error) {
+	return nil, nil
+}
+
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type CreateUserInput struct {
+	Body CreateUserBodyInput
+}
+
+type CreateUserBodyInput struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type CreateUserOutput struct {
+	Body User
+}
+
+func (h *UserHandler) CreateUser(ctx context.Context, in *CreateUserInput) (*CreateUserOutput, error) {
+	return nil, nil
+}
+
+type GetUserInput struct {
+	UserID string `path:"userId"`
+}
+
+type GetUserOutput struct {
+	Body User
+}
+
+func (h *UserHandler) GetUser(ctx context.Context, in *GetUserInput) (*GetUserOutput, error) {
+	return nil, nil
+}
+
+type UpdateAvatarInput struct {
+	ID   string `path:"id"`
+	Body []byte
+}
+
+type UpdateAvatarOutput struct{}
+
+func (h *UserHandler) UpdateAvatar(ctx context.Context, in *UpdateAvatarInput) (*UpdateAvatarOutput, error) {
+	return nil, nil
+}
+
+type UpdateUserInput struct {
+	ID string `path:"id"`
+}
+
+type UpdateUserOutput struct{}
+
+func (h *UserHandler) UpdateUser(ctx context.Context, in *UpdateUserInput) (*UpdateUserOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,39 @@
+package adminhandler // want package:"routes"
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type AdminHandler struct{}
+
+func (h *AdminHandler) Routes(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-user",
+		Method:      http.MethodGet,
+		Path:        "/users/{user}",
+	}, h.GetUser)
+	huma.Register(api, huma.Operation{
+		OperationID: "list-audits",
+		Method:      http.MethodGet,
+		Path:        "/audits",
+	}, h.ListAudits)
+}
+
+type GetUserInput struct{}
+
+type GetUserOutput struct{}
+
+func (h *AdminHandler) GetUser(ctx context.Context, in *GetUserInput) (*GetUserOutput, error) {
+	return nil, nil
+}
+
+type ListAuditsInput struct{}
+
+type ListAuditsOutput struct{}
+
+func (h *AdminHandler) ListAudits(ctx context.Context, in *ListAuditsInput) (*ListAuditsOutput, error) {
+	return nil, nil
+}
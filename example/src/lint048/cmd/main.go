@@ -0,0 +1,11 @@
+package main
+
+import (
+	"lint048/adminhandler"
+	"lint048/userhandler"
+)
+
+func main() { // want `LINT-048: route "GET /users/\{id\}" registered at userhandler/routes.go:14 is equivalent to "GET /users/\{user\}" registered at adminhandler/routes.go:16`
+	(&adminhandler.AdminHandler{}).Routes(nil)
+	(&userhandler.UserHandler{}).Routes()
+}
@@ -0,0 +1,3 @@
+module lint048
+
+go 1.26
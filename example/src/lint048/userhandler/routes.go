@@ -0,0 +1,34 @@
+package userhandler // want package:"routes"
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+type UserHandler struct{}
+
+func (h *UserHandler) Routes() {
+	Handle(Operation{}.WithPattern("GET /users/{id}"), h.GetUser)
+	Handle(Operation{}.WithPattern("GET /users/{userId}"), h.GetUser) // want `LINT-048: route "GET /users/\{userId\}" is equivalent to "GET /users/\{id\}" registered at userhandler/routes.go:14`
+	Handle(Operation{}.WithPattern("GET /users/{id}"), h.GetUser)     // want `LINT-048: route "GET /users/\{id\}" duplicates "GET /users/\{id\}" registered at userhandler/routes.go:14`
+	Handle(Operation{}.WithPattern("GET /users/{id}/posts"), h.GetUser)
+	Handle(Operation{}.WithPattern("GET /users/me/{section}"), h.GetUser) // want `LINT-048: route "GET /users/me/\{section\}" conflicts with "GET /users/\{id\}/posts" registered at userhandler/routes.go:17`
+	Handle(Operation{}.WithPattern("GET /users/me"), h.GetUser)           // ok: more specific than /users/{id}
+	Handle(Operation{}.WithPattern("HEAD /users/{id}"), h.GetUser)        // want `LINT-048: route "HEAD /users/\{id\}" conflicts with "GET /users/me" registered at userhandler/routes.go:19`
+	Handle(Operation{}.WithPattern("/files/"), h.GetUser)
+	Handle(Operation{}.WithPattern("/files/{path...}"), h.GetUser) // want `LINT-048: route "/files/\{path...\}" is equivalent to "/files/" registered at userhandler/routes.go:21`
+	Handle(Operation{}.WithPattern("GET /files/{$}"), h.GetUser)   // ok: more specific than /files/
+	Handle(Operation{}.WithPattern("HEAD /health"), h.GetUser)     // ok: more specific than GET
+	Handle(Operation{}.WithPattern("GET /health"), h.GetUser)
+}
+
+type GetUserInput struct{}
+
+type GetUserOutput struct{}
+
+func (h *UserHandler) GetUser(ctx context.Context, in *GetUserInput) (*GetUserOutput, error) {
+	return nil, nil
+}
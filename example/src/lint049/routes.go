@@ -0,0 +1,43 @@
+package userhandler
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+type UserHandler struct{}
+
+func (h *UserHandler) Routes() {
+	Handle(Operation{}.WithPattern("GET /api/v1/users/{userId}"), h.Handle)
+	Handle(Operation{}.WithPattern("POST /api/v1/users"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/user-groups/{groupId}/members"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/files/{path...}"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/openapi.json"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/people/{personId}"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/{$}"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/userGroups"), h.Handle)       // want `LINT-049: path segment "userGroups" of route "GET /api/v1/userGroups" must be kebab-case`
+	Handle(Operation{}.WithPattern("GET /api/v1/user_groups"), h.Handle)      // want `LINT-049: path segment "user_groups" of route "GET /api/v1/user_groups" must be kebab-case`
+	Handle(Operation{}.WithPattern("GET /api/v1/user/{userId}"), h.Handle)    // want `LINT-049: collection segment "user" of route "GET /api/v1/user/\{userId\}" must be plural \("users"\)`
+	Handle(Operation{}.WithPattern("GET /api/v1/user-group/{id}"), h.Handle)  // want `LINT-049: collection segment "user-group" of route "GET /api/v1/user-group/\{id\}" must be plural \("user-groups"\)`
+	Handle(Operation{}.WithPattern("GET /api/v1/category/{id}"), h.Handle)    // want `LINT-049: collection segment "category" of route "GET /api/v1/category/\{id\}" must be plural \("categories"\)`
+	Handle(Operation{}.WithPattern("GET /api/v1/users/"), h.Handle)           // want `LINT-049: route "GET /api/v1/users/" must not end with a slash`
+	Handle(Operation{}.WithPattern("POST /api/v1/create-user"), h.Handle)     // want `LINT-049: path segment "create-user" of route "POST /api/v1/create-user" is a verb, use the HTTP method instead`
+	Handle(Operation{}.WithPattern("POST /api/v1/users/{userId}"), h.Handle)  // want `LINT-049: POST route "POST /api/v1/users/\{userId\}" must target a collection, not an item path ending in a parameter`
+	Handle(Operation{}.WithPattern("POST /api/v1/files/{path...}"), h.Handle) // want `LINT-049: POST route "POST /api/v1/files/\{path...\}" must target a collection, not an item path ending in a parameter`
+	Handle(Operation{}.WithPattern("GET /internal/health"), h.Handle)         // want `LINT-049: route "GET /internal/health" must start with "/api/v1"`
+	Handle(Operation{}.WithPattern("POST /api/v1/users/{userId}/roles"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/products/{productId}/add-ons"), h.Handle)
+	Handle(Operation{}.WithPattern("GET /api/v1/address-books"), h.Handle)
+	Handle(Operation{}.WithPattern("POST /api/v1/users/{userId}/add-role"), h.Handle) // want `LINT-049: path segment "add-role" of route "POST /api/v1/users/\{userId\}/add-role" is a verb, use the HTTP method instead`
+}
+
+type Input struct{}
+
+type Output struct{}
+
+func (h *UserHandler) Handle(ctx context.Context, in *Input) (*Output, error) {
+	return nil, nil
+}
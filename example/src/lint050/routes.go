@@ -0,0 +1,74 @@
+package assethandler
+
+import "context"
+
+type Operation struct{}
+
+func (o Operation) WithPattern(_ string) Operation { return o }
+
+func Handle[I, O any](op Operation, h func(context.Context, *I) (*O, error)) {}
+
+type AssetHandler struct{}
+
+func (h *AssetHandler) Routes() {
+	Handle(Operation{}.WithPattern("GET /assets/{assetId}"), h.GetAsset)
+	Handle(Operation{}.WithPattern("GET /assets"), h.ListAssets)
+	Handle(Operation{}.WithPattern("POST /assets"), h.CreateAsset)
+	Handle(Operation{}.WithPattern("PATCH /assets/{assetId}"), h.UpdateAsset)
+	Handle(Operation{}.WithPattern("DELETE /assets/{assetId}"), h.DeleteAsset)
+	Handle(Operation{}.WithPattern("GET /files/{path...}"), h.GetAsset)
+	Handle(Operation{}.WithPattern("POST /assets/{assetId}/publish"), h.PublishAsset) // ok: no mapping for Publish
+	Handle(Operation{}.WithPattern("GET /assets/{assetId}"), h.Getaway)               // ok: Get is not a word of Getaway
+
+	Handle(Operation{}.WithPattern("POST /assets/{assetId}"), h.GetAsset)    // want `LINT-050: handler AssetHandler.GetAsset must be registered with GET, got POST`
+	Handle(Operation{}.WithPattern("GET /assets"), h.GetAsset)               // want `LINT-050: handler AssetHandler.GetAsset must be registered on an item path ending in a parameter, got "/assets"`
+	Handle(Operation{}.WithPattern("GET /assets/{assetId}"), h.ListAssets)   // want `LINT-050: handler AssetHandler.ListAssets must be registered on a collection path, got "/assets/\{assetId\}"`
+	Handle(Operation{}.WithPattern("PUT /assets"), h.CreateAsset)            // want `LINT-050: handler AssetHandler.CreateAsset must be registered with POST, got PUT`
+	Handle(Operation{}.WithPattern("POST /assets/{assetId}"), h.UpdateAsset) // want `LINT-050: handler AssetHandler.UpdateAsset must be registered with PUT or PATCH, got POST`
+	Handle(Operation{}.WithPattern("/assets/{assetId}"), h.DeleteAsset)      // want `LINT-050: handler AssetHandler.DeleteAsset must be registered with DELETE, got no method`
+}
+
+type (
+	GetAssetInput      struct{}
+	GetAssetOutput     struct{}
+	ListAssetsInput    struct{}
+	ListAssetsOutput   struct{}
+	CreateAssetInput   struct{}
+	CreateAssetOutput  struct{}
+	UpdateAssetInput   struct{}
+	UpdateAssetOutput  struct{}
+	DeleteAssetInput   struct{}
+	DeleteAssetOutput  struct{}
+	PublishAssetInput  struct{}
+	PublishAssetOutput struct{}
+	GetawayInput       struct{}
+	GetawayOutput      struct{}
+)
+
+func (h *AssetHandler) GetAsset(ctx context.Context, in *GetAssetInput) (*GetAssetOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) ListAssets(ctx context.Context, in *ListAssetsInput) (*ListAssetsOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) CreateAsset(ctx context.Context, in *CreateAssetInput) (*CreateAssetOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) UpdateAsset(ctx context.Context, in *UpdateAssetInput) (*UpdateAssetOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) DeleteAsset(ctx context.Context, in *DeleteAssetInput) (*DeleteAssetOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) PublishAsset(ctx context.Context, in *PublishAssetInput) (*PublishAssetOutput, error) {
+	return nil, nil
+}
+
+func (h *AssetHandler) Getaway(ctx context.Context, in *GetawayInput) (*GetawayOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,51 @@
+package assethandler // want package:"operations"
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type AssetHandler struct{}
+
+func (h *AssetHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-assets",
+		Method:      http.MethodGet,
+		Path:        "/assets",
+	}, h.ListAssets)
+	huma.Register(api, huma.Operation{
+		OperationID: "getAsset", // want `LINT-051: huma operation ID "getAsset" of GetAsset must be "get-asset"`
+		Method:      http.MethodGet,
+		Path:        "/assets/{assetId}",
+	}, h.GetAsset)
+	huma.Register(api, huma.Operation{ // want `LINT-051: huma operation for GetAssetByID must set OperationID "get-asset-by-id"`
+		Method: http.MethodGet,
+		Path:   "/assets/by-id/{assetId}",
+	}, h.GetAssetByID)
+	huma.Register(api, huma.Operation{
+		OperationID: "list-assets", // want `LINT-051: huma operation ID "list-assets" is already used at assethandler/handler.go:14`
+		Method:      http.MethodGet,
+		Path:        "/v2/assets",
+	}, h.ListAssets)
+	huma.Register(api, huma.Operation{
+		OperationID: "export-assets",
+		Method:      http.MethodGet,
+		Path:        "/assets/export",
+	}, exportAssets) // want `LINT-051: huma handler must be a method of a \*\{Name\}Handler type of package assethandler`
+	huma.Register(api, huma.Operation{
+		OperationID: "archive-asset",
+		Method:      http.MethodPost,
+		Path:        "/assets/{assetId}/archive",
+	}, h.ArchiveAsset) // want `LINT-051: huma handler ArchiveAsset must have signature func\(context.Context, \*ArchiveAssetInput\) \(\*ArchiveAssetOutput, error\)`
+	huma.Get(api, "/assets/{assetId}/raw", h.GetAssetRaw)
+}
+
+type ExportAssetsInput struct{}
+
+type ExportAssetsOutput struct{}
+
+func exportAssets(ctx context.Context, in *ExportAssetsInput) (*ExportAssetsOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,43 @@
+package assethandler
+
+import "context"
+
+type ListAssetsInput struct{}
+
+type ListAssetsOutput struct{}
+
+func (h *AssetHandler) ListAssets(ctx context.Context, in *ListAssetsInput) (*ListAssetsOutput, error) {
+	return nil, nil
+}
+
+type GetAssetInput struct{}
+
+type GetAssetOutput struct{}
+
+func (h *AssetHandler) GetAsset(ctx context.Context, in *GetAssetInput) (*GetAssetOutput, error) {
+	return nil, nil
+}
+
+type GetAssetByIDInput struct{}
+
+type GetAssetByIDOutput struct{}
+
+func (h *AssetHandler) GetAssetByID(ctx context.Context, in *GetAssetByIDInput) (*GetAssetByIDOutput, error) {
+	return nil, nil
+}
+
+type ArchiveInput struct{}
+
+type ArchiveOutput struct{}
+
+func (h *AssetHandler) ArchiveAsset(ctx context.Context, in *ArchiveInput) (*ArchiveOutput, error) {
+	return nil, nil
+}
+
+type GetAssetRawInput struct{}
+
+type GetAssetRawOutput struct{}
+
+func (h *AssetHandler) GetAssetRaw(ctx context.Context, in *GetAssetRawInput) (*GetAssetRawOutput, error) {
+	return nil, nil
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"lint051/assethandler"
+	"lint051/userhandler"
+)
+
+func main() { // want `LINT-051: huma operation ID "list-assets" is used at both assethandler/handler.go:14 and userhandler/handler.go:14`
+	(&assethandler.AssetHandler{}).Register(nil)
+	(&userhandler.UserHandler{}).Register(nil)
+}
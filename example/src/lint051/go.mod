@@ -0,0 +1,3 @@
+module lint051
+
+go 1.26
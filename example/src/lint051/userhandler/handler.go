@@ -0,0 +1,39 @@
+package userhandler // want package:"operations"
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type UserHandler struct{}
+
+func (h *UserHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-assets", // want `LINT-051: huma operation ID "list-assets" of ListAssets must be "list-user-assets"`
+		Method:      http.MethodGet,
+		Path:        "/users/{userId}/assets",
+	}, h.ListAssets)
+	huma.Register(api, huma.Operation{
+		OperationID: "get-user",
+		Method:      http.MethodGet,
+		Path:        "/users/{userId}",
+	}, h.Get)
+}
+
+type ListAssetsInput struct{}
+
+type ListAssetsOutput struct{}
+
+func (h *UserHandler) ListAssets(ctx context.Context, in *ListAssetsInput) (*ListAssetsOutput, error) {
+	return nil, nil
+}
+
+type GetInput struct{}
+
+type GetOutput struct{}
+
+func (h *UserHandler) Get(ctx context.Context, in *GetInput) (*GetOutput, error) {
+	return nil, nil
+}
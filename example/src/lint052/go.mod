@@ -0,0 +1,3 @@
+module lint052
+
+go 1.26
@@ -0,0 +1,57 @@
+package userhandler
+
+import "context"
+
+type UserHandler struct{}
+
+type GetUserInput struct {
+	UserID string `path:"userId"`
+	Fields string `query:"fields"`
+}
+
+type GetUserOutput struct {
+	Body GetUserBodyOutput
+}
+
+type GetUserBodyOutput struct {
+	ID        string               `json:"id"`
+	FirstName string               // want `LINT-052: exported field GetUserBodyOutput.FirstName must have a json tag`
+	AvatarURL string               `validate:"url"`             // want `LINT-052: exported field GetUserBodyOutput.AvatarURL must have a json tag`
+	LastName  string               `json:"last_name,omitempty"` // want `LINT-052: json name "last_name" of GetUserBodyOutput.LastName must be lowerCamelCase`
+	Nickname  string               `json:",omitempty"`          // want `LINT-052: exported field GetUserBodyOutput.Nickname must have a json tag`
+	OwnerID   string               `json:"ownerID"`             // want `LINT-052: json name "ownerID" of GetUserBodyOutput.OwnerID must be lowerCamelCase`
+	Address   GetUserAddressOutput `json:"address"`
+	Internal  string               `json:"-"`
+	secret    string
+}
+
+type GetUserAddressOutput struct {
+	City string // want `LINT-052: exported field GetUserAddressOutput.City must have a json tag`
+}
+
+func (h *UserHandler) GetUser(ctx context.Context, in *GetUserInput) (*GetUserOutput, error) {
+	return nil, nil
+}
+
+type Audit struct {
+	CreatedAt string `json:"createdAt"`
+	ID        string `json:"id"`
+}
+
+type UpdateUserInput struct {
+	UserID string `path:"userId"`
+	Audit
+	ID    string `json:"id"` // want `LINT-052: json name "id" of UpdateUserInput.ID is already used by UpdateUserInput.Audit.ID`
+	Name  string `json:"name"`
+	Alias string `json:"name"` // want `LINT-052: json name "name" of UpdateUserInput.Alias is already used by UpdateUserInput.Name`
+}
+
+type UpdateUserOutput struct{}
+
+func (h *UserHandler) UpdateUser(ctx context.Context, in *UpdateUserInput) (*UpdateUserOutput, error) {
+	return nil, nil
+}
+
+type userCache struct {
+	Name string
+}
@@ -0,0 +1,7 @@
+package handlertypes
+
+type Tenant struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"` // want `LINT-052: json name "displayName" of Tenant.DisplayName must be snake_case`
+	HTTPStatus  int    // want `LINT-052: exported field Tenant.HTTPStatus must have a json tag`
+}
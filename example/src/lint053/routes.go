@@ -0,0 +1,30 @@
+package userhandler
+
+import "context"
+
+type UserHandler struct{}
+
+type Paging struct {
+	PageSize int    `query:"pageSize"`
+	Cursor   string `query:"cursor"`
+}
+
+type ListUsersInput struct {
+	Paging
+	OrgID     string `path:"orgId"`
+	SortOrder string `query:"sort_order"`    // want `LINT-053: query tag "sort_order" must be lowerCamelCase`
+	RequestID string `header:"x-request-id"` // want `LINT-053: header tag "x-request-id" must be Canonical-Header-Case`
+	Tenant    string `header:"X-Tenant" required:"true"`
+	Size      int    `query:"pagesize"` // want `LINT-053: query:"pagesize" of ListUsersInput.Size duplicates query:"pageSize" of ListUsersInput.PageSize`
+	Org       string `header:"OrgId"`   // want `LINT-053: header tag "OrgId" must be Canonical-Header-Case` `LINT-053: header:"OrgId" of ListUsersInput.Org duplicates path:"orgId" of ListUsersInput.OrgID`
+	trace     string `header:"X-Trace"` // want `LINT-053: header tag "X-Trace" of unexported field ListUsersInput.trace is ignored at runtime`
+}
+
+type ListUsersOutput struct {
+	Body []string
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, input *ListUsersInput) (*ListUsersOutput, error) {
+	_ = input.trace
+	return &ListUsersOutput{}, nil
+}
@@ -0,0 +1,40 @@
+package adminhandler // want package:"payloads"
+
+import (
+	"context"
+
+	"lint054/handlertypes"
+)
+
+type AdminHandler struct{}
+
+type Profile struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type GetProfileInput struct {
+	UserID string `path:"userId"`
+}
+
+type GetProfileOutput struct {
+	Body *Profile
+}
+
+func (h *AdminHandler) GetProfile(ctx context.Context, input *GetProfileInput) (*GetProfileOutput, error) {
+	return &GetProfileOutput{}, nil
+}
+
+type ContactBodyOutput struct {
+	Email string `json:"email"`
+	Name  string `json:"fullName"`
+}
+
+type ListTenantsOutput struct {
+	Body []handlertypes.Tenant
+}
+
+func (h *AdminHandler) ListTenants(ctx context.Context, input *struct{}) (*ListTenantsOutput, error) {
+	_ = handlertypes.UserBodyOutput{}
+	return &ListTenantsOutput{}, nil
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"lint054/adminhandler"
+	"lint054/userhandler"
+)
+
+func main() { // want `LINT-054: handlertypes.Address \(handlertypes/types.go:14\) is only used by package lint054/userhandler and should be moved there` `LINT-054: body structs adminhandler.Profile \(adminhandler/handler.go:11\), userhandler.ProfileBodyOutput \(userhandler/handler.go:11\) are identical and should be extracted into handlertypes`
+	_ = &adminhandler.AdminHandler{}
+	_ = &userhandler.UserHandler{}
+}
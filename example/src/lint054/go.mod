@@ -0,0 +1,3 @@
+module lint054
+
+go 1.26
@@ -0,0 +1,22 @@
+package handlertypes // want package:"payloads"
+
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type Tenant struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Balance Money  `json:"balance"`
+}
+
+type Address struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+}
+
+type UserBodyOutput struct { // want `LINT-054: handlertypes struct UserBodyOutput must use a descriptive name such as User, not \*BodyOutput`
+	ID     string `json:"id"`
+	Tenant Tenant `json:"tenant"`
+}
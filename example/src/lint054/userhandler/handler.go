@@ -0,0 +1,53 @@
+package userhandler // want package:"payloads"
+
+import (
+	"context"
+
+	"lint054/handlertypes"
+)
+
+type UserHandler struct{}
+
+type ProfileBodyOutput struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+type GetProfileInput struct {
+	UserID string `path:"userId"`
+}
+
+type GetProfileOutput struct {
+	Body ProfileBodyOutput
+}
+
+func (h *UserHandler) GetProfile(ctx context.Context, input *GetProfileInput) (*GetProfileOutput, error) {
+	return &GetProfileOutput{}, nil
+}
+
+type GetUserInput struct {
+	UserID string `path:"userId"`
+}
+
+type GetUserOutput struct {
+	Body handlertypes.UserBodyOutput
+}
+
+func (h *UserHandler) GetUser(ctx context.Context, input *GetUserInput) (*GetUserOutput, error) {
+	return &GetUserOutput{}, nil
+}
+
+type UpdateAddressInput struct {
+	UserID string `path:"userId"`
+	Body   handlertypes.Address
+}
+
+type UpdateAddressOutput struct {
+	Body struct {
+		Balance handlertypes.Money `json:"balance"`
+	}
+}
+
+func (h *UserHandler) UpdateAddress(ctx context.Context, input *UpdateAddressInput) (*UpdateAddressOutput, error) {
+	return &UpdateAddressOutput{}, nil
+}
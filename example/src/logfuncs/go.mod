@@ -0,0 +1,3 @@
+module logfuncs
+
+go 1.26
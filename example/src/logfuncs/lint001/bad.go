@@ -0,0 +1,19 @@
+package lint001
+
+import (
+	"context"
+	"log/slog"
+
+	"logfuncs/logx"
+	"logfuncs/zap"
+)
+
+func Bad(ctx context.Context, w *logx.Wrapper, sugar *zap.SugaredLogger) {
+	slog.InfoContext(ctx, "msg", "UserID", 1)             // want `LINT-001: slog key "UserID" must be lowercase_snake_case`
+	logx.FromContext(ctx).Info("msg", "RequestID", "abc") // want `LINT-001: slog key "RequestID" must be lowercase_snake_case`
+	w.Info("msg", "HttpStatus", 500)                      // want `LINT-001: slog key "HttpStatus" must be lowercase_snake_case`
+	w.Notice("msg", "HttpStatus", 500)                    // ok - not a slog logging method
+	sugar.Infow("msg", "TenantID", "t")                   // want `LINT-001: slog key "TenantID" must be lowercase_snake_case`
+	logx.Event(ctx, "signup", "msg", "PlanID", "p")       // want `LINT-001: slog key "PlanID" must be lowercase_snake_case`
+	_ = slog.String("UserID", "u")                        // want `LINT-001: slog key "UserID" must be lowercase_snake_case`
+}
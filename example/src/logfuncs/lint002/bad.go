@@ -0,0 +1,17 @@
+package lint002
+
+import (
+	"context"
+
+	"logfuncs/logx"
+	"logfuncs/zap"
+)
+
+func Bad(ctx context.Context, w *logx.Wrapper, sugar *zap.SugaredLogger) {
+	logx.FromContext(ctx).Info("User created") // want `LINT-002: slog message "User created" must start with a lowercase letter`
+	w.Info("User created")                     // want `LINT-002: slog message "User created" must start with a lowercase letter`
+	sugar.Infow("User created")                // want `LINT-002: slog message "User created" must start with a lowercase letter`
+	logx.Event(ctx, "Signup", "User created")  // want `LINT-002: slog message "User created" must start with a lowercase letter`
+	logx.FromContext(ctx).Info("user created") // ok
+	w.Notice("User created")                   // ok - not a slog logging method
+}
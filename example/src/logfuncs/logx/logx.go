@@ -0,0 +1,33 @@
+package logx
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Logger embeds *slog.Logger: its logging methods are promoted from slog.
+type Logger struct {
+	*slog.Logger
+}
+
+// Wrapper holds a *slog.Logger and mirrors its logging methods.
+type Wrapper struct {
+	logger *slog.Logger
+}
+
+func FromContext(_ context.Context) *Logger {
+	return &Logger{Logger: slog.Default()}
+}
+
+func (w *Wrapper) Info(msg string, args ...any) {
+	w.logger.Info(msg, args...)
+}
+
+func (w *Wrapper) Notice(msg string, args ...any) {
+	w.logger.Info(msg, args...)
+}
+
+// Event logs msg with key-value pairs starting after the event name.
+func Event(_ context.Context, _ string, msg string, args ...any) {
+	slog.Info(msg, args...)
+}
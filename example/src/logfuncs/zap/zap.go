@@ -0,0 +1,5 @@
+package zap
+
+type SugaredLogger struct{}
+
+func (s *SugaredLogger) Infow(_ string, _ ...any) {}
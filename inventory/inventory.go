@@ -0,0 +1,234 @@
+// Package inventory lists the HTTP routes of a module, as found by
+// analysisutil.FindRoutes, for the `relint routes` command.
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+// Route is one route of the inventory.
+type Route struct {
+	Method  string   `json:"method"`
+	Path    string   `json:"path"`
+	Params  []string `json:"params"`
+	Handler Handler  `json:"handler"`
+	File    string   `json:"file"`
+	Line    int      `json:"line"`
+	Input   *Payload `json:"input,omitempty"`
+	Output  *Payload `json:"output,omitempty"`
+}
+
+// Handler identifies the handler of a route. Receiver and Method are empty
+// when the handler is a function literal or cannot be resolved.
+type Handler struct {
+	Package  string `json:"package"`
+	Receiver string `json:"receiver,omitempty"`
+	Method   string `json:"method,omitempty"`
+}
+
+// Payload describes an Input or Output type.
+type Payload struct {
+	Type   string  `json:"type"`
+	Path   []Field `json:"path,omitempty"`
+	Query  []Field `json:"query,omitempty"`
+	Header []Field `json:"header,omitempty"`
+	Body   *Body   `json:"body,omitempty"`
+}
+
+// Body describes the body of a payload: the type of its Body field, or the
+// json fields of the payload itself.
+type Body struct {
+	Type   string  `json:"type,omitempty"`
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Field is a payload field and the name it is exposed under.
+type Field struct {
+	Field string `json:"field"`
+	Name  string `json:"name"`
+}
+
+// Load returns the routes of the packages matching patterns, relative to dir,
+// sorted by path, method, file and line.
+func Load(dir string, patterns ...string) ([]Route, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:  dir,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, fmt.Errorf("failed to load packages")
+	}
+
+	var routes []Route
+	for _, pkg := range pkgs {
+		pass := &analysis.Pass{
+			Fset:      pkg.Fset,
+			Files:     pkg.Syntax,
+			Pkg:       pkg.Types,
+			TypesInfo: pkg.TypesInfo,
+		}
+		for _, r := range analysisutil.FindRoutes(pass, pkg.Syntax) {
+			routes = append(routes, newRoute(pass, dir, r))
+		}
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		a, b := routes[i], routes[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Method != b.Method {
+			return a.Method < b.Method
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+	return routes, nil
+}
+
+func newRoute(pass *analysis.Pass, dir string, r analysisutil.Route) Route {
+	pos := pass.Fset.Position(r.Pattern.Pos())
+	file := pos.Filename
+	if rel, err := filepath.Rel(dir, file); err == nil && !strings.HasPrefix(rel, "..") {
+		file = filepath.ToSlash(rel)
+	}
+
+	route := Route{
+		Method:  r.Method,
+		Path:    r.Path,
+		Params:  analysisutil.PathParams(r.Path),
+		Handler: Handler{Package: pass.Pkg.Path()},
+		File:    file,
+		Line:    pos.Line,
+	}
+	if route.Params == nil {
+		route.Params = []string{}
+	}
+	if fn := r.HandlerFunc; fn != nil {
+		if fn.Pkg() != nil {
+			route.Handler.Package = fn.Pkg().Path()
+		}
+		route.Handler.Method = fn.Name()
+		if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+			route.Handler.Receiver = types.TypeString(recv.Type(), types.RelativeTo(fn.Pkg()))
+		}
+	}
+	if r.Input != nil {
+		route.Input = newPayload(r.Input)
+	}
+	if r.Output != nil {
+		route.Output = newPayload(r.Output)
+	}
+	return route
+}
+
+func newPayload(t *types.Named) *Payload {
+	p := &Payload{
+		Type:   t.Obj().Name(),
+		Path:   taggedFields(t, "path"),
+		Query:  taggedFields(t, "query"),
+		Header: taggedFields(t, "header"),
+	}
+
+	body, fields := analysisutil.PayloadBody(t)
+	if body != nil {
+		p.Body = &Body{Type: types.TypeString(body.Type(), types.RelativeTo(t.Obj().Pkg())), Fields: toFields(fields)}
+	} else if len(fields) > 0 {
+		p.Body = &Body{Fields: toFields(fields)}
+	}
+	return p
+}
+
+func taggedFields(t *types.Named, key string) []Field {
+	return toFields(analysisutil.TaggedFields(t, key))
+}
+
+func toFields(fields []analysisutil.TaggedField) []Field {
+	var out []Field
+	for _, f := range fields {
+		out = append(out, Field{Field: f.Field.Name(), Name: f.Name})
+	}
+	return out
+}
+
+// WriteJSON writes routes as an indented JSON array.
+func WriteJSON(w io.Writer, routes []Route) error {
+	if routes == nil {
+		routes = []Route{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(routes)
+}
+
+// WriteMarkdown writes routes as a Markdown table.
+func WriteMarkdown(w io.Writer, routes []Route) error {
+	var b strings.Builder
+	b.WriteString("| Method | Path | Handler | Input | Output | Location |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+	for _, r := range routes {
+		fmt.Fprintf(&b, "| %s | `%s` | %s | %s | %s | %s:%d |\n",
+			r.Method, r.Path, r.Handler.markdown(), r.Input.markdown(), r.Output.markdown(), r.File, r.Line)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (h Handler) markdown() string {
+	switch {
+	case h.Method == "":
+		return "`" + h.Package + "`"
+	case h.Receiver == "":
+		return fmt.Sprintf("`%s.%s`", h.Package, h.Method)
+	default:
+		return fmt.Sprintf("`%s` `(%s).%s`", h.Package, h.Receiver, h.Method)
+	}
+}
+
+func (p *Payload) markdown() string {
+	if p == nil {
+		return ""
+	}
+	parts := []string{"`" + p.Type + "`"}
+	for _, group := range []struct {
+		name   string
+		fields []Field
+	}{{"path", p.Path}, {"query", p.Query}, {"header", p.Header}} {
+		if len(group.fields) > 0 {
+			parts = append(parts, group.name+": "+fieldNames(group.fields))
+		}
+	}
+	if p.Body != nil {
+		body := "body: "
+		if p.Body.Type != "" {
+			body += "`" + p.Body.Type + "` "
+		}
+		parts = append(parts, strings.TrimSpace(body+fieldNames(p.Body.Fields)))
+	}
+	return strings.Join(parts, "<br>")
+}
+
+func fieldNames(fields []Field) string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,147 @@
+package inventory_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/alexisvisco/relint/inventory"
+)
+
+func testdataDir(t *testing.T) string {
+	return exampleDir(t, "inventory")
+}
+
+func exampleDir(t *testing.T, name string) string {
+	t.Helper()
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("failed to resolve test file path")
+	}
+	return filepath.Join(filepath.Dir(file), "..", "example", "src", name)
+}
+
+func TestLoad(t *testing.T) {
+	routes, err := inventory.Load(testdataDir(t), ".")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := inventory.WriteJSON(&buf, routes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []inventory.Route
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	const pkg = "github.com/alexisvisco/relint/example/src/inventory"
+	want := []inventory.Route{
+		{
+			Method:  "GET",
+			Path:    "/users",
+			Params:  []string{},
+			Handler: inventory.Handler{Package: pkg, Method: "ListUsers"},
+			File:    "routes.go",
+			Line:    15,
+			Input: &inventory.Payload{
+				Type:  "ListUsersInput",
+				Query: []inventory.Field{{Field: "Cursor", Name: "cursor"}},
+			},
+			Output: &inventory.Payload{Type: "ListUsersOutput"},
+		},
+		{
+			Method:  "PATCH",
+			Path:    "/users/{userId}",
+			Params:  []string{"userId"},
+			Handler: inventory.Handler{Package: pkg, Receiver: "*UserHandler", Method: "UpdateUser"},
+			File:    "routes.go",
+			Line:    14,
+			Input: &inventory.Payload{
+				Type:   "UpdateUserInput",
+				Path:   []inventory.Field{{Field: "UserID", Name: "userId"}},
+				Query:  []inventory.Field{{Field: "DryRun", Name: "dryRun"}},
+				Header: []inventory.Field{{Field: "IfMatch", Name: "If-Match"}},
+				Body: &inventory.Body{
+					Type:   "UpdateUserBodyInput",
+					Fields: []inventory.Field{{Field: "Name", Name: "name"}, {Field: "Email", Name: "email"}},
+				},
+			},
+			Output: &inventory.Payload{
+				Type:   "UpdateUserOutput",
+				Header: []inventory.Field{{Field: "ETag", Name: "ETag"}},
+				Body:   &inventory.Body{Fields: []inventory.Field{{Field: "ID", Name: "id"}}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected routes:\ngot:  %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestLoadHuma(t *testing.T) {
+	routes, err := inventory.Load(exampleDir(t, "inventoryhuma"), ".")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []inventory.Route{
+		{
+			Method:  "GET",
+			Path:    "/assets",
+			Params:  []string{},
+			Handler: inventory.Handler{Package: "inventoryhuma", Receiver: "*AssetHandler", Method: "ListAssets"},
+			File:    "routes.go",
+			Line:    18,
+			Input:   &inventory.Payload{Type: "ListAssetsInput"},
+			Output:  &inventory.Payload{Type: "ListAssetsOutput"},
+		},
+		{
+			Method:  "GET",
+			Path:    "/assets/{assetId}",
+			Params:  []string{"assetId"},
+			Handler: inventory.Handler{Package: "inventoryhuma", Receiver: "*AssetHandler", Method: "GetAsset"},
+			File:    "routes.go",
+			Line:    16,
+			Input: &inventory.Payload{
+				Type: "GetAssetInput",
+				Path: []inventory.Field{{Field: "AssetID", Name: "assetId"}},
+			},
+			Output: &inventory.Payload{
+				Type: "GetAssetOutput",
+				Body: &inventory.Body{Type: "AssetBodyOutput", Fields: []inventory.Field{{Field: "ID", Name: "id"}}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(routes, want) {
+		t.Fatalf("unexpected routes:\ngot:  %+v\nwant: %+v", routes, want)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	routes, err := inventory.Load(testdataDir(t), ".")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := inventory.WriteMarkdown(&buf, routes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"| Method | Path | Handler | Input | Output | Location |",
+		"| PATCH | `/users/{userId}` | `github.com/alexisvisco/relint/example/src/inventory` `(*UserHandler).UpdateUser` |",
+		"path: userId<br>query: dryRun<br>header: If-Match<br>body: `UpdateUserBodyInput` name, email",
+		"| routes.go:14 |",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output, got:\n%s", want, out)
+		}
+	}
+}
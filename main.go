@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -10,9 +12,19 @@ import (
 	"golang.org/x/tools/go/analysis/multichecker"
 
 	"github.com/alexisvisco/relint/all"
+	"github.com/alexisvisco/relint/analysisutil"
+	"github.com/alexisvisco/relint/inventory"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "routes" {
+		if err := runRoutes(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(2)
+		}
+		return
+	}
+
 	showVersion, args, err := stripVersionArgs(os.Args)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -23,6 +35,16 @@ func main() {
 		return
 	}
 
+	logFuncs, args, err := stripLogFuncsArgs(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+	if err := analysisutil.SetLogFuncs(logFuncs); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+
 	args, err = preprocessArgs(args, all.Analyzers)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -32,6 +54,37 @@ func main() {
 	multichecker.Main(all.Analyzers...)
 }
 
+// runRoutes implements `relint routes [-format=json|markdown] [packages]`,
+// which prints the inventory of the routes registered in packages.
+func runRoutes(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("routes", flag.ContinueOnError)
+	format := fs.String("format", "json", "output format: json or markdown")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "markdown" {
+		return fmt.Errorf("invalid value for -format: %q", *format)
+	}
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	routes, err := inventory.Load(dir, patterns...)
+	if err != nil {
+		return err
+	}
+
+	if *format == "markdown" {
+		return inventory.WriteMarkdown(w, routes)
+	}
+	return inventory.WriteJSON(w, routes)
+}
+
 func preprocessArgs(args []string, analyzers []*analysis.Analyzer) ([]string, error) {
 	if len(args) == 0 {
 		return args, nil
@@ -147,3 +200,37 @@ func parseVersionArg(arg string) (handled bool, enabled bool, err error) {
 
 	return false, false, nil
 }
+
+// stripLogFuncsArgs extracts the -log-funcs flag, which declares additional
+// logging functions shared by all logging rules.
+func stripLogFuncsArgs(args []string) (logFuncs string, filtered []string, err error) {
+	if len(args) == 0 {
+		return "", args, nil
+	}
+
+	filtered = make([]string, 0, len(args))
+	filtered = append(filtered, args[0])
+
+	rest := args[1:]
+	for i := 0; i < len(rest); i++ {
+		arg := rest[i]
+		if arg == "-log-funcs" || arg == "--log-funcs" {
+			if i+1 >= len(rest) {
+				return "", nil, fmt.Errorf("missing value for -log-funcs")
+			}
+			logFuncs = rest[i+1]
+			i++
+			continue
+		}
+
+		const shortPrefix = "-log-funcs="
+		const longPrefix = "--log-funcs="
+		if strings.HasPrefix(arg, shortPrefix) || strings.HasPrefix(arg, longPrefix) {
+			logFuncs = strings.TrimPrefix(strings.TrimPrefix(arg, shortPrefix), longPrefix)
+			continue
+		}
+		filtered = append(filtered, arg)
+	}
+
+	return logFuncs, filtered, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"io"
 	"slices"
 	"testing"
 
@@ -108,3 +109,46 @@ func TestStripVersionArgs_InvalidValue(t *testing.T) {
 		t.Fatal("expected error for invalid -version value")
 	}
 }
+
+func TestStripLogFuncsArgs(t *testing.T) {
+	logFuncs, args, err := stripLogFuncsArgs([]string{"relint", "-log-funcs=example.com/logx.Info:msg=0:args=1", "./..."})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logFuncs != "example.com/logx.Info:msg=0:args=1" {
+		t.Fatalf("unexpected log funcs: %q", logFuncs)
+	}
+	if slices.Contains(args, "-log-funcs=example.com/logx.Info:msg=0:args=1") {
+		t.Fatalf("log-funcs flag should be removed from args: %v", args)
+	}
+	if !slices.Contains(args, "./...") {
+		t.Fatalf("expected package args to be preserved: %v", args)
+	}
+}
+
+func TestStripLogFuncsArgs_SeparateValue(t *testing.T) {
+	logFuncs, args, err := stripLogFuncsArgs([]string{"relint", "--log-funcs", "example.com/logx.Info:msg=0", "./..."})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logFuncs != "example.com/logx.Info:msg=0" {
+		t.Fatalf("unexpected log funcs: %q", logFuncs)
+	}
+	if !slices.Equal(args, []string{"relint", "./..."}) {
+		t.Fatalf("unexpected args: %v", args)
+	}
+}
+
+func TestStripLogFuncsArgs_MissingValue(t *testing.T) {
+	_, _, err := stripLogFuncsArgs([]string{"relint", "-log-funcs"})
+	if err == nil {
+		t.Fatal("expected error for missing -log-funcs value")
+	}
+}
+
+func TestRunRoutes_InvalidFormat(t *testing.T) {
+	err := runRoutes([]string{"-format=yaml", "./..."}, io.Discard)
+	if err == nil {
+		t.Fatal("expected error for invalid -format value")
+	}
+}
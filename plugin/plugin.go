@@ -4,10 +4,19 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/alexisvisco/relint/all"
+	"github.com/alexisvisco/relint/analysisutil"
 )
 
 // New returns the list of analyzers for use as a golangci-lint plugin.
+// conf may set "log-funcs" with the same syntax as the -log-funcs flag.
 func New(conf any) ([]*analysis.Analyzer, error) {
+	if settings, ok := conf.(map[string]any); ok {
+		if logFuncs, ok := settings["log-funcs"].(string); ok {
+			if err := analysisutil.SetLogFuncs(logFuncs); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return all.Analyzers, nil
 }
 
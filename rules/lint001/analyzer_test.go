@@ -7,11 +7,23 @@ import (
 
 	"golang.org/x/tools/go/analysis/analysistest"
 
+	"github.com/alexisvisco/relint/analysisutil"
 	"github.com/alexisvisco/relint/rules/lint001"
 )
 
 func TestAnalyzer(t *testing.T) {
 	_, thisFile, _, _ := runtime.Caller(0)
 	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
-	analysistest.Run(t, testdata, lint001.Analyzer, "lint001")
+	analysistest.Run(t, testdata, lint001.Analyzer, "lint001", "lint001attrs")
+}
+
+func TestAnalyzerLogFuncs(t *testing.T) {
+	if err := analysisutil.SetLogFuncs("logfuncs/logx.Event:msg=2:args=3,(*logfuncs/zap.SugaredLogger).Infow:msg=0:args=1"); err != nil {
+		t.Fatalf("failed to set log funcs: %v", err)
+	}
+	t.Cleanup(func() { _ = analysisutil.SetLogFuncs("") })
+
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.Run(t, testdata, lint001.Analyzer, "logfuncs/lint001")
 }
@@ -25,31 +25,14 @@ func run(pass *analysis.Pass) (interface{}, error) {
 
 	insp.Preorder(nodeFilter, func(n ast.Node) {
 		call := n.(*ast.CallExpr)
-		if !analysisutil.IsSlogCall(pass, call) {
-			return
-		}
-
-		args := call.Args
-		if len(args) == 0 {
-			return
-		}
-
-		// Check if it's slog.Log / slog.LogContext (first arg is context/level, not message)
-		sel, ok := call.Fun.(*ast.SelectorExpr)
+		lc, ok := analysisutil.ParseLogCall(pass, call)
 		if !ok {
 			return
 		}
-		name := sel.Sel.Name
-		// slog.Log(ctx, level, msg, ...) — msg is at index 2
-		// slog.LogAttrs(ctx, level, msg, ...) — msg is at index 2
-		msgIdx := 0
-		if name == "Log" || name == "LogAttrs" {
-			msgIdx = 2
-		} else if name == "InfoContext" || name == "ErrorContext" || name == "WarnContext" || name == "DebugContext" {
-			msgIdx = 1
-		}
 
-		if msgIdx >= len(args) {
+		args := call.Args
+		msgIdx := lc.MsgIndex
+		if msgIdx < 0 || msgIdx >= len(args) {
 			return
 		}
 
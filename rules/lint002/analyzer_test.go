@@ -7,6 +7,7 @@ import (
 
 	"golang.org/x/tools/go/analysis/analysistest"
 
+	"github.com/alexisvisco/relint/analysisutil"
 	"github.com/alexisvisco/relint/rules/lint002"
 )
 
@@ -15,3 +16,14 @@ func TestAnalyzer(t *testing.T) {
 	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
 	analysistest.RunWithSuggestedFixes(t, testdata, lint002.Analyzer, "lint002")
 }
+
+func TestAnalyzerLogFuncs(t *testing.T) {
+	if err := analysisutil.SetLogFuncs("logfuncs/logx.Event:msg=2:args=3,(*logfuncs/zap.SugaredLogger).Infow:msg=0:args=1"); err != nil {
+		t.Fatalf("failed to set log funcs: %v", err)
+	}
+	t.Cleanup(func() { _ = analysisutil.SetLogFuncs("") })
+
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.RunWithSuggestedFixes(t, testdata, lint002.Analyzer, "logfuncs/lint002")
+}
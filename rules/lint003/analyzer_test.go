@@ -17,5 +17,5 @@ func TestAnalyzer(t *testing.T) {
 	lint003.Analyzer.Flags.Set("dot-notation", "error=error.message,userId=user.id,userID=user.id,sessionId=session.id,sessionID=session.id")
 	t.Cleanup(func() { lint003.Analyzer.Flags.Set("dot-notation", "") })
 
-	analysistest.Run(t, testdata, lint003.Analyzer, "lint003")
+	analysistest.Run(t, testdata, lint003.Analyzer, "lint003", "lint003attrs")
 }
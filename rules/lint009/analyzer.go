@@ -2,11 +2,12 @@ package lint009
 
 import (
 	"go/ast"
-	"strings"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
 )
 
 var (
@@ -29,22 +30,11 @@ func init() {
 	)
 }
 
-func parseSet(flag string) map[string]bool {
-	m := make(map[string]bool)
-	for _, s := range strings.Split(flag, ",") {
-		s = strings.TrimSpace(s)
-		if s != "" {
-			m[s] = true
-		}
-	}
-	return m
-}
-
 func run(pass *analysis.Pass) (interface{}, error) {
-	exceptions := parseSet(exceptionsFlag)
+	exceptions := analysisutil.SplitSet(exceptionsFlag)
 
 	pkgName := pass.Pkg.Name()
-	if !isPluralPackageName(pkgName) || exceptions[pkgName] {
+	if !analysisutil.IsPlural(pkgName) || exceptions[pkgName] {
 		return nil, nil
 	}
 
@@ -61,20 +51,3 @@ func run(pass *analysis.Pass) (interface{}, error) {
 
 	return nil, nil
 }
-
-func isPluralPackageName(name string) bool {
-	name = strings.TrimSpace(strings.ToLower(name))
-	if len(name) < 2 {
-		return false
-	}
-	if !strings.HasSuffix(name, "s") {
-		return false
-	}
-
-	// Common singular endings that should not be treated as plural.
-	if strings.HasSuffix(name, "ss") || strings.HasSuffix(name, "us") || strings.HasSuffix(name, "is") {
-		return false
-	}
-
-	return true
-}
@@ -12,7 +12,7 @@ import (
 
 var Analyzer = &analysis.Analyzer{
 	Name:     "lint015",
-	Doc:      "LINT-015: store/service/handler files with layer methods must contain exactly one exported method",
+	Doc:      "LINT-015: store/service/handler/worker files with layer methods must contain exactly one exported method",
 	Run:      run,
 	Requires: []*analysis.Analyzer{inspect.Analyzer},
 }
@@ -20,6 +20,7 @@ var Analyzer = &analysis.Analyzer{
 var exemptFiles = map[string]bool{
 	"store.go":     true,
 	"service.go":   true,
+	"worker.go":    true,
 	"fx_module.go": true,
 }
 
@@ -27,7 +28,8 @@ func run(pass *analysis.Pass) (interface{}, error) {
 	pkgName := pass.Pkg.Name()
 	if !strings.Contains(pkgName, "store") &&
 		!strings.Contains(pkgName, "service") &&
-		!strings.Contains(pkgName, "handler") {
+		!strings.Contains(pkgName, "handler") &&
+		!strings.Contains(pkgName, "worker") {
 		return nil, nil
 	}
 
@@ -39,7 +41,7 @@ func run(pass *analysis.Pass) (interface{}, error) {
 
 		count := exportedStoreServiceMethodCount(f)
 		if count > 1 {
-			pass.Reportf(f.Pos(), "LINT-015: file %q in store/service/handler package must contain exactly one exported store/service/handler method, found %d", basename, count)
+			pass.Reportf(f.Pos(), "LINT-015: file %q in store/service/handler/worker package must contain exactly one exported store/service/handler/worker method, found %d", basename, count)
 		}
 	}
 
@@ -65,6 +67,12 @@ func exportedStoreServiceMethodCount(file *ast.File) int {
 			strings.HasSuffix(recvIdent.Name, "Service") ||
 			strings.HasSuffix(recvIdent.Name, "Handler") {
 			count++
+			continue
+		}
+		// Workers only expose their entry points: Run and Handle* methods.
+		if strings.HasSuffix(recvIdent.Name, "Worker") &&
+			(fn.Name.Name == "Run" || strings.HasPrefix(fn.Name.Name, "Handle")) {
+			count++
 		}
 	}
 	return count
@@ -13,5 +13,5 @@ import (
 func TestAnalyzer(t *testing.T) {
 	_, thisFile, _, _ := runtime.Caller(0)
 	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
-	analysistest.Run(t, testdata, lint015.Analyzer, "lint015", "lint015functions", "lint015handler")
+	analysistest.Run(t, testdata, lint015.Analyzer, "lint015", "lint015functions", "lint015handler", "lint015worker")
 }
@@ -11,7 +11,7 @@ import (
 
 var Analyzer = &analysis.Analyzer{
 	Name: "lint019",
-	Doc:  "LINT-019: FxModule must be declared in handler.go/service.go/store.go depending on package suffix",
+	Doc:  "LINT-019: FxModule must be declared in handler.go/service.go/store.go/worker.go depending on package suffix",
 	Run:  run,
 }
 
@@ -58,6 +58,8 @@ func expectedFxModuleFile(pkgName string) string {
 		return "service.go"
 	case strings.HasSuffix(pkgName, "store"):
 		return "store.go"
+	case strings.HasSuffix(pkgName, "worker"):
+		return "worker.go"
 	default:
 		return ""
 	}
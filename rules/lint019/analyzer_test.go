@@ -23,8 +23,10 @@ func TestAnalyzer(t *testing.T) {
 		"lint019wrongstore",
 		"lint019wrongservice",
 		"lint019wronghandler",
+		"lint019wrongworker",
 		"lint019okstore",
 		"lint019okservice",
 		"lint019okhandler",
+		"lint019okworker",
 	)
 }
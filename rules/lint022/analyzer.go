@@ -1,11 +1,9 @@
 package lint022
 
 import (
-	"slices"
-	"fmt"
 	"go/ast"
+	"slices"
 	"strings"
-	"unicode"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
@@ -69,50 +67,5 @@ func run(pass *analysis.Pass) (interface{}, error) {
 }
 
 func expectedRouteFiles(handlerName, routeName string) []string {
-	handlerSnake := toSnake(handlerName)
-	routeSnake := toSnake(routeName)
-	routePart := normalizeRoutePart(handlerSnake, routeSnake)
-	if routePart != "" {
-		return []string{fmt.Sprintf("%s.go", routePart)}
-	}
-	return []string{fmt.Sprintf("%s.go", routeSnake)}
-}
-
-func normalizeRoutePart(handlerSnake, routeSnake string) string {
-	routePart := routeSnake
-	aliases := []string{handlerSnake, pluralize(handlerSnake)}
-	for _, alias := range aliases {
-		routePart = strings.TrimPrefix(routePart, alias+"_")
-		routePart = strings.TrimSuffix(routePart, "_"+alias)
-	}
-	routePart = strings.Trim(routePart, "_")
-	if routePart == handlerSnake || routePart == pluralize(handlerSnake) {
-		return ""
-	}
-	return routePart
-}
-
-func pluralize(s string) string {
-	if strings.HasSuffix(s, "y") && len(s) > 1 {
-		prev := s[len(s)-2]
-		if !strings.ContainsRune("aeiou", rune(prev)) {
-			return s[:len(s)-1] + "ies"
-		}
-	}
-	if strings.HasSuffix(s, "s") || strings.HasSuffix(s, "x") || strings.HasSuffix(s, "z") ||
-		strings.HasSuffix(s, "ch") || strings.HasSuffix(s, "sh") {
-		return s + "es"
-	}
-	return s + "s"
-}
-
-func toSnake(s string) string {
-	var result []rune
-	for i, r := range s {
-		if unicode.IsUpper(r) && i > 0 {
-			result = append(result, '_')
-		}
-		result = append(result, unicode.ToLower(r))
-	}
-	return string(result)
+	return []string{analysisutil.ExpectedRouteFile(handlerName, routeName)}
 }
@@ -3,20 +3,20 @@ package lint031
 import (
 	"go/ast"
 	"go/token"
-	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
-	"unicode"
 
 	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
 )
 
 var pathParamPattern = regexp.MustCompile(`\{([^{}]+)\}`)
 
 var Analyzer = &analysis.Analyzer{
 	Name: "lint031",
-	Doc:  "LINT-031: httpapi path params must be lowerCamelCase",
+	Doc:  "LINT-031: httpapi and huma path params must be lowerCamelCase",
 	Run:  run,
 }
 
@@ -25,6 +25,7 @@ func run(pass *analysis.Pass) (interface{}, error) {
 		checkPathTags(pass, f)
 		checkHTTPAPIRoutePathParams(pass, f)
 	}
+	checkHumaPathParams(pass)
 
 	return nil, nil
 }
@@ -61,7 +62,7 @@ func checkHTTPAPIRoutePathParams(pass *analysis.Pass, f *ast.File) {
 				continue
 			}
 			param := normalizePathParam(m[1])
-			if isLowerCamel(param) {
+			if analysisutil.IsLowerCamel(param) {
 				continue
 			}
 			pass.Reportf(patternLit.Pos(), "LINT-031: httpapi path param %q must be lowerCamelCase", m[1])
@@ -70,26 +71,29 @@ func checkHTTPAPIRoutePathParams(pass *analysis.Pass, f *ast.File) {
 	})
 }
 
+// checkHumaPathParams checks the Path of huma.Register operations and of
+// huma.Get, huma.Post, ... calls.
+func checkHumaPathParams(pass *analysis.Pass) {
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		if !route.Huma {
+			continue
+		}
+		for _, param := range analysisutil.PathParams(route.Path) {
+			if !analysisutil.IsLowerCamel(param) {
+				pass.Reportf(route.Pattern.Pos(), "LINT-031: huma path param %q must be lowerCamelCase", param)
+			}
+		}
+	}
+}
+
 func checkPathTags(pass *analysis.Pass, f *ast.File) {
 	ast.Inspect(f, func(n ast.Node) bool {
 		field, ok := n.(*ast.Field)
-		if !ok || field.Tag == nil || field.Tag.Kind != token.STRING {
-			return true
-		}
-
-		rawTag, err := strconv.Unquote(field.Tag.Value)
-		if err != nil {
-			return true
-		}
-		pathTag := reflect.StructTag(rawTag).Get("path")
-		if pathTag == "" {
-			return true
-		}
-		pathName := strings.Split(pathTag, ",")[0]
-		if pathName == "" || pathName == "-" {
+		if !ok {
 			return true
 		}
-		if isLowerCamel(pathName) {
+		pathName, ok := analysisutil.TagName(field, "path")
+		if !ok || analysisutil.IsLowerCamel(pathName) {
 			return true
 		}
 		pass.Reportf(field.Tag.Pos(), "LINT-031: path tag %q must be lowerCamelCase", pathName)
@@ -100,22 +104,3 @@ func checkPathTags(pass *analysis.Pass, f *ast.File) {
 func normalizePathParam(param string) string {
 	return strings.TrimSuffix(param, "...")
 }
-
-func isLowerCamel(s string) bool {
-	if s == "" {
-		return false
-	}
-	for i, r := range s {
-		if i == 0 {
-			if !unicode.IsLower(r) {
-				return false
-			}
-			continue
-		}
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			continue
-		}
-		return false
-	}
-	return true
-}
@@ -13,5 +13,5 @@ import (
 func TestAnalyzer(t *testing.T) {
 	_, thisFile, _, _ := runtime.Caller(0)
 	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
-	analysistest.Run(t, testdata, lint031.Analyzer, "lint031", "lint031ok")
+	analysistest.Run(t, testdata, lint031.Analyzer, "lint031", "lint031ok", "lint031huma")
 }
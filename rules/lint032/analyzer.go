@@ -44,7 +44,7 @@ func run(pass *analysis.Pass) (interface{}, error) {
 }
 
 func isLayerPackage(name string) bool {
-	if strings.HasSuffix(name, "store") || strings.HasSuffix(name, "service") {
+	if strings.HasSuffix(name, "store") || strings.HasSuffix(name, "service") || strings.HasSuffix(name, "worker") {
 		return true
 	}
 	return strings.HasSuffix(name, "handler") && name != "handler"
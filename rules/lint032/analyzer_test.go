@@ -13,5 +13,5 @@ import (
 func TestAnalyzer(t *testing.T) {
 	_, thisFile, _, _ := runtime.Caller(0)
 	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
-	analysistest.Run(t, testdata, lint032.Analyzer, "lint032badname", "lint032multiple", "lint032ok", "lint032nonlayer", "lint032legacyhandler")
+	analysistest.Run(t, testdata, lint032.Analyzer, "lint032badname", "lint032multiple", "lint032ok", "lint032nonlayer", "lint032legacyhandler", "lint032worker")
 }
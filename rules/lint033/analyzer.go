@@ -0,0 +1,280 @@
+package lint033
+
+import (
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint033",
+	Doc:       "LINT-033: types Store/Service/Worker interfaces must be implemented and their methods used",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*layerUsageFact)(nil)},
+}
+
+// layerUsageFact summarizes what a single package contributes to the
+// module-wide view: the layer interfaces it declares, the layer structs
+// implementing them and the layer methods it calls.
+type layerUsageFact struct {
+	Interfaces      []interfaceDecl
+	Implementations []implementation
+	Calls           []string
+}
+
+type interfaceDecl struct {
+	Key     string
+	Methods []interfaceMethod
+}
+
+type interfaceMethod struct {
+	Name string
+	Key  string
+}
+
+type implementation struct {
+	Interface string
+	Type      string
+}
+
+func (*layerUsageFact) AFact() {}
+
+func (f *layerUsageFact) String() string {
+	return "layerUsage"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	fact := &layerUsageFact{
+		Interfaces: declaredInterfaces(pass),
+		Calls:      calledMethods(pass),
+	}
+	if isLayerPackage(pass.Pkg.Name()) {
+		fact.Implementations = implementations(pass)
+	}
+	if len(fact.Interfaces) > 0 || len(fact.Implementations) > 0 || len(fact.Calls) > 0 {
+		pass.ExportPackageFact(fact)
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportModuleUsage(pass)
+	}
+
+	return nil, nil
+}
+
+// declaredInterfaces collects Store/Service/Worker interfaces of a types package.
+func declaredInterfaces(pass *analysis.Pass) []interfaceDecl {
+	if pass.Pkg.Name() != "types" {
+		return nil
+	}
+
+	var out []interfaceDecl
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !isLayerInterfaceName(name) {
+			continue
+		}
+		iface, ok := tn.Type().Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+		decl := interfaceDecl{Key: typeKey(tn)}
+		for i := 0; i < iface.NumMethods(); i++ {
+			m := iface.Method(i)
+			decl.Methods = append(decl.Methods, interfaceMethod{Name: m.Name(), Key: methodKey(m)})
+		}
+		out = append(out, decl)
+	}
+	return out
+}
+
+// implementations records, for every struct declared in a layer package, the
+// types interfaces (from any imported types package) it implements. Layer
+// structs implementing none of them are reported as orphans.
+func implementations(pass *analysis.Pass) []implementation {
+	ifaces := importedLayerInterfaces(pass.Pkg)
+
+	var out []implementation
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+		if _, ok := tn.Type().Underlying().(*types.Struct); !ok {
+			continue
+		}
+
+		ptr := types.NewPointer(tn.Type())
+		implemented := false
+		for _, iface := range ifaces {
+			if !types.Implements(ptr, iface.Type().Underlying().(*types.Interface)) {
+				continue
+			}
+			implemented = true
+			out = append(out, implementation{Interface: typeKey(iface), Type: typeKey(tn)})
+		}
+
+		if !implemented && tn.Exported() && isLayerInterfaceName(name) {
+			pass.Reportf(tn.Pos(), "LINT-033: %q implements no types.*Store/*Service/*Worker interface", name)
+		}
+	}
+	return out
+}
+
+// calledMethods collects methods of layer interfaces, and of concrete types
+// declared in other packages, that are selected in this package.
+func calledMethods(pass *analysis.Pass) []string {
+	seen := make(map[string]bool)
+	for _, sel := range pass.TypesInfo.Selections {
+		if sel.Kind() == types.FieldVal {
+			continue
+		}
+		fn, ok := sel.Obj().(*types.Func)
+		if !ok {
+			continue
+		}
+		recv := receiverTypeName(fn)
+		if recv == nil || recv.Pkg() == nil {
+			continue
+		}
+		if types.IsInterface(recv.Type()) {
+			if recv.Pkg().Name() != "types" {
+				continue
+			}
+		} else if recv.Pkg() == pass.Pkg {
+			continue
+		}
+		seen[methodKey(fn)] = true
+	}
+
+	out := make([]string, 0, len(seen))
+	for k := range seen {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// reportModuleUsage cross-references the facts of every package reachable
+// from a main package.
+func reportModuleUsage(pass *analysis.Pass) {
+	var ifaces []interfaceDecl
+	implsByIface := make(map[string][]string)
+	called := make(map[string]bool)
+
+	for _, pf := range pass.AllPackageFacts() {
+		fact, ok := pf.Fact.(*layerUsageFact)
+		if !ok {
+			continue
+		}
+		ifaces = append(ifaces, fact.Interfaces...)
+		for _, impl := range fact.Implementations {
+			implsByIface[impl.Interface] = append(implsByIface[impl.Interface], impl.Type)
+		}
+		for _, c := range fact.Calls {
+			called[c] = true
+		}
+	}
+	if len(ifaces) == 0 {
+		return
+	}
+
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].Key < ifaces[j].Key })
+	pos := analysisutil.MainReportPos(pass)
+	for _, iface := range ifaces {
+		impls := implsByIface[iface.Key]
+		if len(impls) == 0 {
+			pass.Reportf(pos, "LINT-033: interface %q has no implementation in any store/service/worker package", iface.Key)
+			continue
+		}
+		for _, m := range iface.Methods {
+			if isMethodCalled(m, impls, called) {
+				continue
+			}
+			pass.Reportf(pos, "LINT-033: method %q of interface %q is never called outside its implementation", m.Name, iface.Key)
+		}
+	}
+}
+
+func isMethodCalled(m interfaceMethod, impls []string, called map[string]bool) bool {
+	if called[m.Key] {
+		return true
+	}
+	for _, impl := range impls {
+		if called[impl+"."+m.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+func importedLayerInterfaces(pkg *types.Package) []*types.TypeName {
+	var out []*types.TypeName
+	seen := make(map[*types.Package]bool)
+	var visit func(p *types.Package)
+	visit = func(p *types.Package) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+		if p.Name() == "types" {
+			scope := p.Scope()
+			for _, name := range scope.Names() {
+				tn, ok := scope.Lookup(name).(*types.TypeName)
+				if ok && isLayerInterfaceName(name) && types.IsInterface(tn.Type()) {
+					out = append(out, tn)
+				}
+			}
+		}
+		for _, imp := range p.Imports() {
+			visit(imp)
+		}
+	}
+	visit(pkg)
+	return out
+}
+
+func receiverTypeName(fn *types.Func) *types.TypeName {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return nil
+	}
+	t := sig.Recv().Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	return named.Origin().Obj()
+}
+
+func methodKey(fn *types.Func) string {
+	recv := receiverTypeName(fn)
+	if recv == nil {
+		return fn.FullName()
+	}
+	return typeKey(recv) + "." + fn.Name()
+}
+
+func typeKey(tn *types.TypeName) string {
+	if tn.Pkg() == nil {
+		return tn.Name()
+	}
+	return tn.Pkg().Path() + "." + tn.Name()
+}
+
+func isLayerInterfaceName(name string) bool {
+	return strings.HasSuffix(name, "Store") || strings.HasSuffix(name, "Service") || strings.HasSuffix(name, "Worker")
+}
+
+func isLayerPackage(name string) bool {
+	return strings.HasSuffix(name, "store") || strings.HasSuffix(name, "service") || strings.HasSuffix(name, "worker")
+}
@@ -0,0 +1,17 @@
+package lint033_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint033"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.Run(t, testdata, lint033.Analyzer, "lint033/userstore", "lint033/userservice", "lint033/cmd")
+}
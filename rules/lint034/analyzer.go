@@ -0,0 +1,190 @@
+package lint034
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint034",
+	Doc:  "LINT-034: store/service methods must be in {method}.go files",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	pkgName := pass.Pkg.Name()
+	if !strings.Contains(pkgName, "store") && !strings.Contains(pkgName, "service") {
+		return nil, nil
+	}
+
+	filesByName := make(map[string]*ast.File, len(pass.Files))
+	for _, f := range pass.Files {
+		filesByName[analysisutil.FileBasename(pass, f.Pos())] = f
+	}
+
+	for _, f := range pass.Files {
+		actualFile := analysisutil.FileBasename(pass, f.Pos())
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || !fn.Name.IsExported() {
+				continue
+			}
+			recvName, layer, ok := layerReceiver(fn)
+			if !ok {
+				continue
+			}
+
+			expectedFile := analysisutil.ExpectedMethodFile(strings.TrimSuffix(recvName, layer), fn.Name.Name)
+			if actualFile == expectedFile {
+				continue
+			}
+
+			diag := analysis.Diagnostic{
+				Pos:     fn.Name.Pos(),
+				Message: fmt.Sprintf("LINT-034: %s method %q on %q must be in file %q", strings.ToLower(layer), fn.Name.Name, recvName, expectedFile),
+			}
+			if target, ok := filesByName[expectedFile]; ok {
+				if edits, ok := moveMethodEdits(pass, f, fn, target); ok {
+					diag.SuggestedFixes = []analysis.SuggestedFix{{
+						Message:   fmt.Sprintf("Move %s to %s", fn.Name.Name, expectedFile),
+						TextEdits: edits,
+					}}
+				}
+			}
+			pass.Report(diag)
+		}
+	}
+
+	return nil, nil
+}
+
+func layerReceiver(fn *ast.FuncDecl) (recvName, layer string, ok bool) {
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	recvIdent, ok := recv.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	for _, suffix := range []string{"Store", "Service"} {
+		if strings.HasSuffix(recvIdent.Name, suffix) && recvIdent.Name != suffix {
+			return recvIdent.Name, suffix, true
+		}
+	}
+	return "", "", false
+}
+
+// moveMethodEdits returns the edits moving fn (with its doc comment) from src
+// to the end of dst. No fix is offered when dst lacks an import the method
+// needs, or when the move would leave an unused import behind in src.
+func moveMethodEdits(pass *analysis.Pass, src *ast.File, fn *ast.FuncDecl, dst *ast.File) ([]analysis.TextEdit, bool) {
+	if pass.ReadFile == nil || !importsSatisfied(pass, src, fn, dst) {
+		return nil, false
+	}
+
+	srcFile := pass.Fset.File(src.Pos())
+	content, err := pass.ReadFile(srcFile.Name())
+	if err != nil {
+		return nil, false
+	}
+
+	start := fn.Pos()
+	if fn.Doc != nil {
+		start = fn.Doc.Pos()
+	}
+	startOff := srcFile.Offset(start)
+	endOff := srcFile.Offset(fn.End())
+	if startOff < 0 || endOff > len(content) {
+		return nil, false
+	}
+	method := content[startOff:endOff]
+
+	// Swallow the blank lines around the method so the source file keeps a
+	// single blank line between the remaining declarations.
+	delEnd := endOff
+	for delEnd < len(content) && content[delEnd] == '\n' {
+		delEnd++
+	}
+	delStart := startOff
+	if delEnd == len(content) {
+		for delStart > 0 && content[delStart-1] == '\n' {
+			delStart--
+		}
+		delStart = min(delStart+1, startOff)
+	}
+
+	dstFile := pass.Fset.File(dst.Pos())
+	dstEnd := token.Pos(dstFile.Base() + dstFile.Size())
+
+	var insert bytes.Buffer
+	insert.WriteString("\n")
+	insert.Write(method)
+	insert.WriteString("\n")
+
+	return []analysis.TextEdit{
+		{
+			Pos: srcFile.Pos(delStart),
+			End: srcFile.Pos(delEnd),
+		},
+		{
+			Pos:     dstEnd,
+			End:     dstEnd,
+			NewText: insert.Bytes(),
+		},
+	}, true
+}
+
+func importsSatisfied(pass *analysis.Pass, src *ast.File, fn *ast.FuncDecl, dst *ast.File) bool {
+	used := usedPkgNames(pass, fn)
+	if len(used) == 0 {
+		return true
+	}
+
+	dstImports := make(map[string]bool)
+	for _, spec := range dst.Imports {
+		if pkgName := analysisutil.ImportedPkgName(pass, spec); pkgName != nil {
+			dstImports[pkgName.Name()+" "+pkgName.Imported().Path()] = true
+		}
+	}
+
+	usedElsewhere := make(map[*types.PkgName]bool)
+	for _, decl := range src.Decls {
+		if decl == fn {
+			continue
+		}
+		for pkgName := range usedPkgNames(pass, decl) {
+			usedElsewhere[pkgName] = true
+		}
+	}
+
+	for pkgName := range used {
+		if !dstImports[pkgName.Name()+" "+pkgName.Imported().Path()] || !usedElsewhere[pkgName] {
+			return false
+		}
+	}
+	return true
+}
+
+func usedPkgNames(pass *analysis.Pass, node ast.Node) map[*types.PkgName]bool {
+	out := make(map[*types.PkgName]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if pkgName, ok := pass.TypesInfo.Uses[ident].(*types.PkgName); ok {
+			out[pkgName] = true
+		}
+		return true
+	})
+	return out
+}
@@ -0,0 +1,17 @@
+package lint034_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint034"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.RunWithSuggestedFixes(t, testdata, lint034.Analyzer, "lint034", "lint034service")
+}
@@ -0,0 +1,217 @@
+package lint036
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint036",
+	Doc:      "LINT-036: types packages must only contain interfaces, errors, DTOs, enums and trivial code",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+// ioPackages are packages whose functions and methods perform I/O.
+var ioPackages = map[string]bool{
+	"bufio":        true,
+	"database/sql": true,
+	"io":           true,
+	"io/fs":        true,
+	"io/ioutil":    true,
+	"log":          true,
+	"log/slog":     true,
+	"net":          true,
+	"os":           true,
+	"os/exec":      true,
+}
+
+// ioFuncs are the functions and methods ("Recv.Name") of packages that are
+// otherwise pure (status texts, method constants, header helpers) and that
+// perform I/O: client requests, servers and request/response bodies.
+var ioFuncs = map[string]map[string]bool{
+	"net/http": {
+		"Get":                        true,
+		"Head":                       true,
+		"Post":                       true,
+		"PostForm":                   true,
+		"ListenAndServe":             true,
+		"ListenAndServeTLS":          true,
+		"Serve":                      true,
+		"ServeTLS":                   true,
+		"ServeFile":                  true,
+		"ServeFileFS":                true,
+		"ServeContent":               true,
+		"ReadRequest":                true,
+		"ReadResponse":               true,
+		"Client.Do":                  true,
+		"Client.Get":                 true,
+		"Client.Head":                true,
+		"Client.Post":                true,
+		"Client.PostForm":            true,
+		"Server.ListenAndServe":      true,
+		"Server.ListenAndServeTLS":   true,
+		"Server.Serve":               true,
+		"Server.ServeTLS":            true,
+		"Server.Shutdown":            true,
+		"Transport.RoundTrip":        true,
+		"RoundTripper.RoundTrip":     true,
+		"Handler.ServeHTTP":          true,
+		"HandlerFunc.ServeHTTP":      true,
+		"ServeMux.ServeHTTP":         true,
+		"ResponseWriter.Write":       true,
+		"ResponseWriter.WriteHeader": true,
+		"Request.Write":              true,
+		"Request.WriteProxy":         true,
+		"Request.ParseForm":          true,
+		"Request.ParseMultipartForm": true,
+		"Request.FormValue":          true,
+		"Request.PostFormValue":      true,
+		"Request.FormFile":           true,
+		"Request.MultipartReader":    true,
+		"Response.Write":             true,
+	},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Name() != "types" {
+		return nil, nil
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	insp.Preorder([]ast.Node{(*ast.ImportSpec)(nil)}, func(n ast.Node) {
+		spec := n.(*ast.ImportSpec)
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return
+		}
+		if isLayerImport(importPath) {
+			pass.Reportf(spec.Path.Pos(), "LINT-036: types package must not import layer package %q", importPath)
+		}
+	})
+
+	insp.Preorder([]ast.Node{(*ast.GenDecl)(nil)}, func(n ast.Node) {
+		gd := n.(*ast.GenDecl)
+		if gd.Tok != token.VAR {
+			return
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if name.Name == "_" || isErrorSentinel(pass, vs, i) {
+					continue
+				}
+				pass.Reportf(name.Pos(), "LINT-036: types package must not declare package-level variable %q", name.Name)
+			}
+		}
+	})
+
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		if fn.Recv == nil && fn.Name.Name == "init" {
+			pass.Reportf(fn.Name.Pos(), "LINT-036: types package must not declare init functions")
+			return
+		}
+		if fn.Body == nil {
+			return
+		}
+
+		reported := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if reported {
+				return false
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			if callee, ok := ioCallee(pass, call); ok {
+				pass.Reportf(call.Pos(), "LINT-036: function %q in types package must not perform I/O (calls %s)", fn.Name.Name, callee)
+				reported = true
+			}
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+// isLayerImport reports whether importPath denotes a store, service, handler
+// or model package.
+func isLayerImport(importPath string) bool {
+	last := path.Base(importPath)
+	return last == "model" ||
+		strings.HasSuffix(last, "store") ||
+		strings.HasSuffix(last, "service") ||
+		strings.HasSuffix(last, "handler")
+}
+
+// isErrorSentinel reports whether the i-th name of vs is an Err* variable of
+// type error.
+func isErrorSentinel(pass *analysis.Pass, vs *ast.ValueSpec, i int) bool {
+	name := vs.Names[i]
+	if !strings.HasPrefix(name.Name, "Err") {
+		return false
+	}
+	obj := pass.TypesInfo.Defs[name]
+	return obj != nil && analysisutil.ImplementsError(obj.Type())
+}
+
+// ioCallee returns the qualified name of the called function when it belongs
+// to an I/O package (fmt.Print*/Fprint*/Scan* included).
+func ioCallee(pass *analysis.Pass, call *ast.CallExpr) (string, bool) {
+	fn := analysisutil.CalledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil {
+		return "", false
+	}
+	pkgPath := fn.Pkg().Path()
+	if pkgPath == "fmt" {
+		name := fn.Name()
+		if strings.HasPrefix(name, "Print") || strings.HasPrefix(name, "Fprint") || strings.HasPrefix(name, "Scan") || strings.HasPrefix(name, "Fscan") {
+			return "fmt." + name, true
+		}
+		return "", false
+	}
+	if funcs, ok := ioFuncs[pkgPath]; ok {
+		name := funcName(fn)
+		if !funcs[name] {
+			return "", false
+		}
+		return fn.Pkg().Name() + "." + name, true
+	}
+	if !ioPackages[pkgPath] {
+		return "", false
+	}
+	return fn.Pkg().Name() + "." + fn.Name(), true
+}
+
+// funcName returns the name of fn, qualified by its receiver type name for
+// methods: Get, Client.Do.
+func funcName(fn *types.Func) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return fn.Name()
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+	return fn.Name()
+}
@@ -0,0 +1,17 @@
+package lint036_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint036"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.Run(t, testdata, lint036.Analyzer, "lint036/types", "lint036ok/types")
+}
@@ -0,0 +1,118 @@
+package lint037
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	getPrefixesFlag      string
+	listPrefixesFlag     string
+	mutationPrefixesFlag string
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint037",
+	Doc:      "LINT-037: types Store/Service interface methods must follow context/error/CRUD signature conventions",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&getPrefixesFlag,
+		"get-prefixes",
+		"Get",
+		"comma-separated method name prefixes that must return a single pointer and an error",
+	)
+	Analyzer.Flags.StringVar(
+		&listPrefixesFlag,
+		"list-prefixes",
+		"List",
+		"comma-separated method name prefixes that must return a slice and an error",
+	)
+	Analyzer.Flags.StringVar(
+		&mutationPrefixesFlag,
+		"mutation-prefixes",
+		"Create,Update,Delete",
+		"comma-separated method name prefixes that must return at most one value and an error",
+	)
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Name() != "types" {
+		return nil, nil
+	}
+
+	getPrefixes := analysisutil.SplitList(getPrefixesFlag)
+	listPrefixes := analysisutil.SplitList(listPrefixesFlag)
+	mutationPrefixes := analysisutil.SplitList(mutationPrefixesFlag)
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.TypeSpec)(nil)}, func(n ast.Node) {
+		ts := n.(*ast.TypeSpec)
+		iface, ok := ts.Type.(*ast.InterfaceType)
+		if !ok || iface.Methods == nil {
+			return
+		}
+		ifaceName := ts.Name.Name
+		if !strings.HasSuffix(ifaceName, "Store") && !strings.HasSuffix(ifaceName, "Service") {
+			return
+		}
+
+		for _, field := range iface.Methods.List {
+			for _, name := range field.Names {
+				fn, ok := pass.TypesInfo.Defs[name].(*types.Func)
+				if !ok {
+					continue
+				}
+				sig := fn.Type().(*types.Signature)
+				method := name.Name
+
+				if sig.Params().Len() == 0 || !analysisutil.IsContextType(sig.Params().At(0).Type()) {
+					pass.Reportf(name.Pos(), "LINT-037: method %q of %q must take context.Context as first parameter", method, ifaceName)
+				}
+
+				results := sig.Results()
+				if results.Len() == 0 || !analysisutil.IsErrorType(results.At(results.Len()-1).Type()) {
+					pass.Reportf(name.Pos(), "LINT-037: method %q of %q must return error as last result", method, ifaceName)
+					continue
+				}
+
+				switch {
+				case analysisutil.HasVerbPrefix(method, getPrefixes):
+					if results.Len() != 2 || !isPointer(results.At(0).Type()) {
+						pass.Reportf(name.Pos(), "LINT-037: method %q of %q must return a single pointer and an error", method, ifaceName)
+					}
+				case analysisutil.HasVerbPrefix(method, listPrefixes):
+					if results.Len() != 2 || !isSlice(results.At(0).Type()) {
+						pass.Reportf(name.Pos(), "LINT-037: method %q of %q must return a slice and an error", method, ifaceName)
+					}
+				case analysisutil.HasVerbPrefix(method, mutationPrefixes):
+					if results.Len() > 2 {
+						pass.Reportf(name.Pos(), "LINT-037: method %q of %q must return at most one value and an error", method, ifaceName)
+					}
+				}
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Pointer)
+	return ok
+}
+
+func isSlice(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Slice)
+	return ok
+}
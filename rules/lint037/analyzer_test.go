@@ -0,0 +1,31 @@
+package lint037_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint037"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.Run(t, testdata, lint037.Analyzer, "lint037/types")
+}
+
+func TestAnalyzerCustomPrefixes(t *testing.T) {
+	oldGetPrefixes := lint037.Analyzer.Flags.Lookup("get-prefixes").Value.String()
+	if err := lint037.Analyzer.Flags.Set("get-prefixes", "Find"); err != nil {
+		t.Fatalf("failed to set lint037 get-prefixes flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint037.Analyzer.Flags.Set("get-prefixes", oldGetPrefixes)
+	})
+
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+	analysistest.Run(t, testdata, lint037.Analyzer, "lint037custom/types")
+}
@@ -0,0 +1,204 @@
+package lint038
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"strconv"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	schemaFlag string
+	strictFlag bool
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint038",
+	Doc:      "LINT-038: slog keys must match the log schema (known keys, value types, deprecations)",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&schemaFlag,
+		"schema",
+		"",
+		"path to the JSON log schema, relative to the module root (rule disabled when empty)",
+	)
+	Analyzer.Flags.BoolVar(
+		&strictFlag,
+		"strict",
+		false,
+		"report slog keys that are not declared in the log schema",
+	)
+}
+
+// logSchema is the checked-in description of allowed log keys:
+//
+//	{
+//	  "keys": {
+//	    "user.id":     {"type": "string"},
+//	    "duration_ms": {"type": "int"},
+//	    "userId":      {"renamed_to": "user.id"},
+//	    "legacy":      {"deprecated": true}
+//	  }
+//	}
+type logSchema struct {
+	Keys map[string]schemaKey `json:"keys"`
+}
+
+type schemaKey struct {
+	// Type is one of string, int, float, bool, duration, time, error or any.
+	Type       string `json:"type"`
+	Deprecated bool   `json:"deprecated"`
+	RenamedTo  string `json:"renamed_to"`
+}
+
+var validTypes = map[string]bool{
+	"":         true,
+	"any":      true,
+	"string":   true,
+	"int":      true,
+	"float":    true,
+	"bool":     true,
+	"duration": true,
+	"time":     true,
+	"error":    true,
+}
+
+var schemaCache sync.Map // path -> *logSchema
+
+func loadSchema(path string) (*logSchema, error) {
+	if cached, ok := schemaCache.Load(path); ok {
+		return cached.(*logSchema), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LINT-038: reading log schema: %w", err)
+	}
+	var schema logSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("LINT-038: parsing log schema %s: %w", path, err)
+	}
+	for key, def := range schema.Keys {
+		if !validTypes[def.Type] {
+			return nil, fmt.Errorf("LINT-038: log schema %s: key %q has unknown type %q", path, key, def.Type)
+		}
+	}
+
+	schemaCache.Store(path, &schema)
+	return &schema, nil
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if schemaFlag == "" {
+		return nil, nil
+	}
+	schema, err := loadSchema(analysisutil.ResolveModulePath(pass, schemaFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		for _, k := range analysisutil.LogKeys(pass, n) {
+			if k.IsGroup {
+				continue
+			}
+			checkKey(pass, schema, k)
+		}
+	})
+
+	return nil, nil
+}
+
+func checkKey(pass *analysis.Pass, schema *logSchema, k analysisutil.LogKey) {
+	def, known := schema.Keys[k.Key]
+	if !known {
+		if strictFlag {
+			pass.Reportf(k.Expr.Pos(), "LINT-038: slog key %q is not declared in the log schema", k.Key)
+		}
+		return
+	}
+
+	if def.RenamedTo != "" {
+		diag := analysis.Diagnostic{
+			Pos:     k.Expr.Pos(),
+			Message: fmt.Sprintf("LINT-038: slog key %q is deprecated, use %q", k.Key, def.RenamedTo),
+		}
+		if lit, ok := k.Expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: fmt.Sprintf("Rename key to %q", def.RenamedTo),
+				TextEdits: []analysis.TextEdit{{
+					Pos:     lit.Pos(),
+					End:     lit.End(),
+					NewText: []byte(strconv.Quote(def.RenamedTo)),
+				}},
+			}}
+		}
+		pass.Report(diag)
+		return
+	}
+	if def.Deprecated {
+		pass.Reportf(k.Expr.Pos(), "LINT-038: slog key %q is deprecated", k.Key)
+		return
+	}
+
+	if def.Type == "" || def.Type == "any" || k.Value == nil {
+		return
+	}
+	actual, ok := valueType(pass.TypesInfo.TypeOf(k.Value))
+	if ok && actual != def.Type {
+		pass.Reportf(k.Value.Pos(), "LINT-038: value of slog key %q must be of type %s according to the log schema, got %s", k.Key, def.Type, actual)
+	}
+}
+
+// valueType maps a Go type to a log schema type. Interface values (including
+// slog.Value) cannot be classified statically and are ignored.
+func valueType(t types.Type) (string, bool) {
+	if t == nil || types.IsInterface(t) && !analysisutil.ImplementsError(t) {
+		return "", false
+	}
+	if named, ok := t.(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil {
+			switch obj.Pkg().Path() + "." + obj.Name() {
+			case "time.Duration":
+				return "duration", true
+			case "time.Time":
+				return "time", true
+			case "log/slog.Value":
+				return "", false
+			}
+		}
+	}
+	if analysisutil.ImplementsError(t) {
+		return "error", true
+	}
+	if basic, ok := t.Underlying().(*types.Basic); ok {
+		info := basic.Info()
+		switch {
+		case info&types.IsString != 0:
+			return "string", true
+		case info&types.IsInteger != 0:
+			return "int", true
+		case info&types.IsFloat != 0:
+			return "float", true
+		case info&types.IsBoolean != 0:
+			return "bool", true
+		}
+	}
+	return types.TypeString(t, (*types.Package).Name), true
+}
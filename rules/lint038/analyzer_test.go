@@ -0,0 +1,29 @@
+package lint038_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint038"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	if err := lint038.Analyzer.Flags.Set("schema", filepath.Join(testdata, "src", "lint038", "log_schema.json")); err != nil {
+		t.Fatalf("failed to set lint038 schema flag: %v", err)
+	}
+	if err := lint038.Analyzer.Flags.Set("strict", "true"); err != nil {
+		t.Fatalf("failed to set lint038 strict flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint038.Analyzer.Flags.Set("schema", "")
+		_ = lint038.Analyzer.Flags.Set("strict", "false")
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, lint038.Analyzer, "lint038")
+}
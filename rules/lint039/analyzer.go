@@ -0,0 +1,329 @@
+package lint039
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint039",
+	Doc:      "LINT-039: slog messages must be constant; dynamic values belong in attributes",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+// verbPattern matches a single fmt verb such as %s, %d, %-5v or %.2f.
+var verbPattern = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z]`)
+
+// unsupportedVerbPattern matches explicit argument indexes and '*' widths,
+// which make the verb/argument mapping non-trivial.
+var unsupportedVerbPattern = regexp.MustCompile(`%[-+# 0]*(\[|\*|[0-9]*\.?\*)`)
+
+// attr is a key-value pair extracted from a dynamic message.
+type attr struct {
+	key   string
+	value ast.Expr
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		lc, ok := analysisutil.ParseLogCall(pass, call)
+		if !ok || lc.MsgIndex < 0 || lc.MsgIndex >= len(call.Args) {
+			return
+		}
+
+		msg := call.Args[lc.MsgIndex]
+		if _, ok := analysisutil.ConstantString(pass, msg); ok {
+			return
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     msg.Pos(),
+			Message: "LINT-039: slog message must be a constant string, pass dynamic values as attributes",
+		}
+		if fix, ok := structuredFix(pass, lc, msg); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+		pass.Report(diag)
+	})
+
+	return nil, nil
+}
+
+// structuredFix rewrites fmt.Sprintf(...) and string concatenations into a
+// constant message followed by key-value pairs.
+func structuredFix(pass *analysis.Pass, lc *analysisutil.LogCall, msg ast.Expr) (analysis.SuggestedFix, bool) {
+	call := lc.Call
+	if lc.ArgsIndex < 0 || call.Ellipsis.IsValid() {
+		return analysis.SuggestedFix{}, false
+	}
+
+	var (
+		parts   []string
+		attrs   []attr
+		ok      bool
+		sprintf *ast.CallExpr
+	)
+	switch m := ast.Unparen(msg).(type) {
+	case *ast.CallExpr:
+		parts, attrs, ok = splitSprintf(pass, m)
+		sprintf = m
+	case *ast.BinaryExpr:
+		parts, attrs, ok = splitConcat(pass, m)
+	}
+	if !ok || len(attrs) == 0 {
+		return analysis.SuggestedFix{}, false
+	}
+
+	text := constantMessage(parts)
+	if text == "" {
+		return analysis.SuggestedFix{}, false
+	}
+
+	used := existingKeys(pass, call)
+	var kv bytes.Buffer
+	for _, a := range attrs {
+		key := uniqueKey(a.key, used)
+		var value bytes.Buffer
+		if err := format.Node(&value, pass.Fset, a.value); err != nil {
+			return analysis.SuggestedFix{}, false
+		}
+		fmt.Fprintf(&kv, ", %s, %s", strconv.Quote(key), value.String())
+	}
+
+	insertPos := call.Args[len(call.Args)-1].End()
+	edits := []analysis.TextEdit{
+		{Pos: msg.Pos(), End: msg.End(), NewText: []byte(strconv.Quote(text))},
+		{Pos: insertPos, End: insertPos, NewText: kv.Bytes()},
+	}
+	if sprintf != nil {
+		importEdits, ok := removeUnusedImport(pass, sprintf, attrs)
+		if !ok {
+			return analysis.SuggestedFix{}, false
+		}
+		edits = append(edits, importEdits...)
+	}
+	return analysis.SuggestedFix{
+		Message:   "Use a constant message with structured attributes",
+		TextEdits: edits,
+	}, true
+}
+
+// removeUnusedImport returns the edit removing the fmt import when the
+// rewritten fmt.Sprintf call is its last use in the file, so that the fixed
+// file still compiles. ok is false when the import cannot be tracked (dot
+// imports).
+func removeUnusedImport(pass *analysis.Pass, sprintf *ast.CallExpr, attrs []attr) ([]analysis.TextEdit, bool) {
+	sel, ok := sprintf.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	pkgName, ok := pass.TypesInfo.Uses[id].(*types.PkgName)
+	if !ok {
+		return nil, false
+	}
+	file := analysisutil.FileOf(pass, sprintf.Pos())
+	if file == nil {
+		return nil, false
+	}
+
+	// Uses of fmt outside the message, or inside the values kept as
+	// attributes, survive the fix.
+	kept := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if kept || !ok || pass.TypesInfo.Uses[id] != pkgName {
+			return !kept
+		}
+		if !contains(sprintf, id) {
+			kept = true
+		}
+		for _, a := range attrs {
+			if contains(a.value, id) {
+				kept = true
+			}
+		}
+		return !kept
+	})
+	if kept {
+		return nil, true
+	}
+
+	tf := pass.Fset.File(file.Pos())
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			is := spec.(*ast.ImportSpec)
+			if analysisutil.ImportedPkgName(pass, is) != pkgName {
+				continue
+			}
+			var node ast.Node = is
+			if len(gd.Specs) == 1 {
+				node = gd
+			}
+			return []analysis.TextEdit{{Pos: tf.LineStart(tf.Line(node.Pos())), End: nextLineStart(tf, node.End())}}, true
+		}
+	}
+	return nil, false
+}
+
+func contains(node ast.Node, id *ast.Ident) bool {
+	return node.Pos() <= id.Pos() && id.End() <= node.End()
+}
+
+// nextLineStart returns the start of the line after the one containing pos,
+// or the end of the file.
+func nextLineStart(tf *token.File, pos token.Pos) token.Pos {
+	if line := tf.Line(pos); line < tf.LineCount() {
+		return tf.LineStart(line + 1)
+	}
+	return token.Pos(tf.Base() + tf.Size())
+}
+
+func splitSprintf(pass *analysis.Pass, call *ast.CallExpr) ([]string, []attr, bool) {
+	fn := analysisutil.CalledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || fn.Name() != "Sprintf" {
+		return nil, nil, false
+	}
+	if len(call.Args) == 0 || call.Ellipsis.IsValid() {
+		return nil, nil, false
+	}
+	layout, ok := analysisutil.ConstantString(pass, call.Args[0])
+	if !ok || unsupportedVerbPattern.MatchString(layout) {
+		return nil, nil, false
+	}
+
+	layout = strings.ReplaceAll(layout, "%%", "")
+	verbs := verbPattern.FindAllStringIndex(layout, -1)
+	args := call.Args[1:]
+	if len(verbs) != len(args) {
+		return nil, nil, false
+	}
+
+	var parts []string
+	var attrs []attr
+	last := 0
+	for i, v := range verbs {
+		parts = append(parts, layout[last:v[0]])
+		last = v[1]
+		attrs = append(attrs, attrFromExpr(pass, args[i]))
+	}
+	parts = append(parts, layout[last:])
+	return parts, attrs, true
+}
+
+func splitConcat(pass *analysis.Pass, expr *ast.BinaryExpr) ([]string, []attr, bool) {
+	if expr.Op != token.ADD {
+		return nil, nil, false
+	}
+
+	var parts []string
+	var attrs []attr
+	var visit func(e ast.Expr)
+	visit = func(e ast.Expr) {
+		e = ast.Unparen(e)
+		if s, ok := analysisutil.ConstantString(pass, e); ok {
+			parts = append(parts, s)
+			return
+		}
+		if bin, ok := e.(*ast.BinaryExpr); ok && bin.Op == token.ADD {
+			visit(bin.X)
+			visit(bin.Y)
+			return
+		}
+		attrs = append(attrs, attrFromExpr(pass, e))
+	}
+	visit(expr)
+	return parts, attrs, true
+}
+
+// attrFromExpr derives a snake_case key from expr. err.Error() is logged as
+// the error value itself under "error".
+func attrFromExpr(pass *analysis.Pass, expr ast.Expr) attr {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 0 {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" && analysisutil.ImplementsError(pass.TypesInfo.TypeOf(sel.X)) {
+			return attr{key: "error", value: sel.X}
+		}
+	}
+	return attr{key: keyFromExpr(expr), value: expr}
+}
+
+func keyFromExpr(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return snakeKey(e.Name)
+	case *ast.SelectorExpr:
+		return snakeKey(e.Sel.Name)
+	case *ast.CallExpr:
+		return keyFromExpr(e.Fun)
+	case *ast.StarExpr:
+		return keyFromExpr(e.X)
+	case *ast.IndexExpr:
+		return keyFromExpr(e.X)
+	case *ast.ParenExpr:
+		return keyFromExpr(e.X)
+	}
+	return "value"
+}
+
+// snakeKey converts an identifier to lowercase_snake_case, keeping acronyms
+// together: userID -> user_id, HTTPStatus -> http_status.
+func snakeKey(name string) string {
+	key := analysisutil.ToSnake(name)
+	if key == "" || !unicode.IsLetter([]rune(key)[0]) {
+		return "value"
+	}
+	return key
+}
+
+// constantMessage joins the constant parts of a message, dropping the
+// separators that used to introduce dynamic values.
+func constantMessage(parts []string) string {
+	var words []string
+	for _, p := range parts {
+		words = append(words, strings.Fields(p)...)
+	}
+	msg := strings.Join(words, " ")
+	return strings.TrimRight(msg, " :=,-")
+}
+
+func existingKeys(pass *analysis.Pass, call *ast.CallExpr) map[string]bool {
+	used := make(map[string]bool)
+	for _, k := range analysisutil.LogKeys(pass, call) {
+		used[k.Key] = true
+	}
+	return used
+}
+
+func uniqueKey(key string, used map[string]bool) string {
+	candidate := key
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", key, i)
+	}
+	used[candidate] = true
+	return candidate
+}
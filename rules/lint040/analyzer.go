@@ -0,0 +1,233 @@
+package lint040
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var keyFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint040",
+	Doc:      "LINT-040: errors in warn/error slog calls must be logged as error values under the canonical key",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&keyFlag,
+		"key",
+		"",
+		`canonical slog key for error values (default: "error", or its -lint003.dot-notation mapping)`,
+	)
+}
+
+// slog.LevelWarn and slog.LevelError.
+const (
+	levelWarn  = 4
+	levelError = 8
+)
+
+// errorKey returns the configured canonical error key.
+func errorKey() string {
+	if keyFlag != "" {
+		return keyFlag
+	}
+	if dotted, ok := analysisutil.DotNotation("error"); ok {
+		return dotted
+	}
+	return "error"
+}
+
+// loggedValue is a value passed to a logging call with its key.
+type loggedValue struct {
+	key     string
+	keyExpr ast.Expr // nil when the key is not a constant
+	value   ast.Expr
+	// lone reports an error passed among key-value arguments without a key.
+	lone bool
+	// inAttr reports a value passed to a typed constructor such as slog.String.
+	inAttr bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	key := errorKey()
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		lc, ok := analysisutil.ParseLogCall(pass, call)
+		if !ok {
+			return
+		}
+		level, ok := callLevel(pass, lc)
+		if !ok || level < levelWarn {
+			return
+		}
+
+		hasError := false
+		for _, v := range loggedValues(pass, lc) {
+			if errValue, ok := errorString(pass, v.value); ok {
+				if v.key == key {
+					hasError = true
+				}
+				diag := analysis.Diagnostic{
+					Pos:     v.value.Pos(),
+					Message: fmt.Sprintf("LINT-040: log the error value under %q instead of calling Error()", key),
+				}
+				if !v.inAttr {
+					diag.SuggestedFixes = []analysis.SuggestedFix{{
+						Message: "Log the error value",
+						TextEdits: []analysis.TextEdit{
+							{Pos: v.value.Pos(), End: v.value.End(), NewText: []byte(exprText(pass, errValue))},
+						},
+					}}
+				}
+				pass.Report(diag)
+				continue
+			}
+			if !analysisutil.ImplementsError(pass.TypesInfo.TypeOf(v.value)) {
+				continue
+			}
+			switch {
+			case v.lone:
+				pass.Reportf(v.value.Pos(), "LINT-040: error value must be logged under key %q", key)
+			case v.keyExpr == nil || v.key == key:
+				// Non-constant keys cannot be checked and are assumed canonical.
+				hasError = true
+			default:
+				// The renamed key already carries the error.
+				hasError = true
+				reportKey(pass, v, key)
+			}
+		}
+
+		if level >= levelError && !hasError && !call.Ellipsis.IsValid() {
+			pass.Reportf(call.Args[lc.MsgIndex].Pos(), "LINT-040: error-level log call carries no error value under key %q", key)
+		}
+	})
+
+	return nil, nil
+}
+
+func reportKey(pass *analysis.Pass, v loggedValue, key string) {
+	diag := analysis.Diagnostic{
+		Pos:     v.keyExpr.Pos(),
+		Message: fmt.Sprintf("LINT-040: error value must be logged under key %q, got %q", key, v.key),
+	}
+	if lit, ok := v.keyExpr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: fmt.Sprintf("Rename key to %q", key),
+			TextEdits: []analysis.TextEdit{
+				{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(key))},
+			},
+		}}
+	}
+	pass.Report(diag)
+}
+
+// callLevel returns the level of a logging call: Warn* and Error* functions
+// have a fixed level, Log and LogAttrs take it as the argument before the
+// message.
+func callLevel(pass *analysis.Pass, lc *analysisutil.LogCall) (int64, bool) {
+	if lc.MsgIndex < 0 || lc.MsgIndex >= len(lc.Call.Args) {
+		return 0, false
+	}
+	name := lc.Func.Name()
+	switch {
+	case strings.HasPrefix(name, "Error"):
+		return levelError, true
+	case strings.HasPrefix(name, "Warn"):
+		return levelWarn, true
+	case (name == "Log" || name == "LogAttrs") && lc.MsgIndex > 0:
+		tv, ok := pass.TypesInfo.Types[lc.Call.Args[lc.MsgIndex-1]]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+			return 0, false
+		}
+		return constant.Int64Val(tv.Value)
+	}
+	return 0, false
+}
+
+// loggedValues returns the key-value pairs and slog.Attr constructor
+// arguments of a logging call.
+func loggedValues(pass *analysis.Pass, lc *analysisutil.LogCall) []loggedValue {
+	args := lc.Call.Args
+	var values []loggedValue
+	if lc.ArgsIndex >= 0 {
+		for i := lc.ArgsIndex; i < len(args); {
+			if analysisutil.IsSlogAttr(pass.TypesInfo.TypeOf(args[i])) {
+				values = append(values, attrValues(pass, args[i])...)
+				i++
+				continue
+			}
+			if i+1 >= len(args) || analysisutil.ImplementsError(pass.TypesInfo.TypeOf(args[i])) {
+				// slog logs a lone value under !BADKEY.
+				values = append(values, loggedValue{value: args[i], lone: true})
+				i++
+				continue
+			}
+			v := loggedValue{value: args[i+1]}
+			if key, ok := analysisutil.ConstantString(pass, args[i]); ok {
+				v.key, v.keyExpr = key, args[i]
+			}
+			values = append(values, v)
+			i += 2
+		}
+	}
+	if lc.AttrsIndex >= 0 {
+		for i := lc.AttrsIndex; i < len(args); i++ {
+			values = append(values, attrValues(pass, args[i])...)
+		}
+	}
+	return values
+}
+
+// attrValues returns the key and value of a slog.String, slog.Any, ... call.
+func attrValues(pass *analysis.Pass, expr ast.Expr) []loggedValue {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return nil
+	}
+	keys := analysisutil.LogKeys(pass, call)
+	if len(keys) != 1 || keys[0].IsGroup {
+		return nil
+	}
+	return []loggedValue{{key: keys[0].Key, keyExpr: keys[0].Expr, value: keys[0].Value, inAttr: true}}
+}
+
+// errorString reports whether expr is err.Error() on an error value and
+// returns err.
+func errorString(pass *analysis.Pass, expr ast.Expr) (ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" || !analysisutil.ImplementsError(pass.TypesInfo.TypeOf(sel.X)) {
+		return nil, false
+	}
+	return sel.X, true
+}
+
+func exprText(pass *analysis.Pass, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, pass.Fset, expr); err != nil {
+		return types.ExprString(expr)
+	}
+	return buf.String()
+}
@@ -0,0 +1,28 @@
+package lint040_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/analysisutil"
+	"github.com/alexisvisco/relint/rules/lint040"
+)
+
+func testdataDir() string {
+	_, thisFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+}
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, testdataDir(), lint040.Analyzer, "lint040")
+}
+
+func TestAnalyzerDotNotation(t *testing.T) {
+	analysisutil.SetDotNotation("error=error.message")
+	t.Cleanup(func() { analysisutil.SetDotNotation("") })
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(), lint040.Analyzer, "lint040dotted")
+}
@@ -0,0 +1,406 @@
+package lint041
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	fieldsFlag string
+	keysFlag   string
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint041",
+	Doc:      "LINT-041: secrets and PII must not reach slog calls",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&fieldsFlag,
+		"fields",
+		"Password,Token,Email",
+		`comma-separated sensitive struct fields, as field names or qualified "pkg.Type.Field"`,
+	)
+	Analyzer.Flags.StringVar(
+		&keysFlag,
+		"keys",
+		"password,token,secret",
+		"comma-separated words that make a slog key sensitive when they appear as a key segment",
+	)
+}
+
+// config holds the parsed flags.
+type config struct {
+	fieldNames map[string]bool
+	qualified  map[string]bool // "pkg.Type.Field", pkg being a package name or path
+	keyWords   map[string]bool
+}
+
+func parseConfig() config {
+	cfg := config{
+		fieldNames: make(map[string]bool),
+		qualified:  make(map[string]bool),
+		keyWords:   make(map[string]bool),
+	}
+	for _, f := range strings.Split(fieldsFlag, ",") {
+		f = strings.TrimSpace(f)
+		switch {
+		case f == "":
+		case strings.Contains(f, "."):
+			cfg.qualified[f] = true
+		default:
+			cfg.fieldNames[f] = true
+		}
+	}
+	for _, k := range strings.Split(keysFlag, ",") {
+		if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+			cfg.keyWords[k] = true
+		}
+	}
+	return cfg
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	c := &checker{
+		pass:    pass,
+		cfg:     parseConfig(),
+		returns: make(map[*types.Func]string),
+	}
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	var funcs []*ast.FuncDecl
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		if fn := n.(*ast.FuncDecl); fn.Body != nil {
+			funcs = append(funcs, fn)
+		}
+	})
+
+	c.summarize(funcs)
+	for _, fn := range funcs {
+		c.checkFunc(fn)
+	}
+	return nil, nil
+}
+
+type checker struct {
+	pass *analysis.Pass
+	cfg  config
+	// returns maps same-package functions returning sensitive data to the
+	// sensitive field they return.
+	returns map[*types.Func]string
+}
+
+// taint maps local variables holding sensitive data to the field they were
+// derived from.
+type taint map[*types.Var]string
+
+// summarize records which functions return sensitive data, iterating until
+// calls between functions of the package are resolved.
+func (c *checker) summarize(funcs []*ast.FuncDecl) {
+	for changed := true; changed; {
+		changed = false
+		for _, fn := range funcs {
+			obj, ok := c.pass.TypesInfo.Defs[fn.Name].(*types.Func)
+			if !ok || c.returns[obj] != "" {
+				continue
+			}
+			t := c.taintFunc(fn.Body)
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if _, ok := n.(*ast.FuncLit); ok {
+					return false
+				}
+				ret, ok := n.(*ast.ReturnStmt)
+				if !ok || c.returns[obj] != "" {
+					return true
+				}
+				for _, r := range ret.Results {
+					if origin := c.origin(t, r); origin != "" {
+						c.returns[obj] = origin
+						changed = true
+						return false
+					}
+				}
+				return true
+			})
+		}
+	}
+}
+
+// taintFunc propagates sensitive data through assignments in body until no
+// new variable is tainted. The analysis is flow-insensitive.
+func (c *checker) taintFunc(body *ast.BlockStmt) taint {
+	t := make(taint)
+	for changed := true; changed; {
+		changed = false
+		mark := func(lhs ast.Expr, rhs ast.Expr) {
+			id, ok := lhs.(*ast.Ident)
+			if !ok {
+				return
+			}
+			v, ok := c.pass.TypesInfo.ObjectOf(id).(*types.Var)
+			if !ok || t[v] != "" {
+				return
+			}
+			if origin := c.origin(t, rhs); origin != "" {
+				t[v] = origin
+				changed = true
+			}
+		}
+		ast.Inspect(body, func(n ast.Node) bool {
+			switch s := n.(type) {
+			case *ast.AssignStmt:
+				if len(s.Lhs) == len(s.Rhs) {
+					for i := range s.Lhs {
+						mark(s.Lhs[i], s.Rhs[i])
+					}
+				}
+			case *ast.ValueSpec:
+				if len(s.Names) == len(s.Values) {
+					for i := range s.Names {
+						mark(s.Names[i], s.Values[i])
+					}
+				}
+			}
+			return true
+		})
+	}
+	return t
+}
+
+// origin returns the sensitive field expr is derived from, or "".
+func (c *checker) origin(t taint, expr ast.Expr) string {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		if v, ok := c.pass.TypesInfo.Uses[e].(*types.Var); ok {
+			return t[v]
+		}
+	case *ast.SelectorExpr:
+		if origin := c.sensitiveField(e); origin != "" {
+			return origin
+		}
+	case *ast.StarExpr:
+		return c.origin(t, e.X)
+	case *ast.UnaryExpr:
+		return c.origin(t, e.X)
+	case *ast.BinaryExpr:
+		if origin := c.origin(t, e.X); origin != "" {
+			return origin
+		}
+		return c.origin(t, e.Y)
+	case *ast.IndexExpr:
+		return c.origin(t, e.X)
+	case *ast.SliceExpr:
+		return c.origin(t, e.X)
+	case *ast.CallExpr:
+		return c.callOrigin(t, e)
+	}
+	return ""
+}
+
+// callOrigin handles conversions, string formatting of sensitive values and
+// calls to package functions returning sensitive data. Other calls (hashing,
+// masking, ...) are assumed to sanitize their arguments.
+func (c *checker) callOrigin(t taint, call *ast.CallExpr) string {
+	if tv, ok := c.pass.TypesInfo.Types[call.Fun]; ok && tv.IsType() && len(call.Args) == 1 {
+		return c.origin(t, call.Args[0])
+	}
+
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	}
+	if ident == nil {
+		return ""
+	}
+	fn, ok := c.pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ""
+	}
+	if origin := c.returns[fn]; origin != "" {
+		return origin
+	}
+	if fn.Pkg().Path() == "fmt" && strings.HasPrefix(fn.Name(), "Sprint") {
+		for _, arg := range call.Args {
+			if origin := c.origin(t, arg); origin != "" {
+				return origin
+			}
+		}
+	}
+	return ""
+}
+
+// sensitiveField returns "Type.Field" when sel reads a sensitive struct field.
+func (c *checker) sensitiveField(sel *ast.SelectorExpr) string {
+	selection, ok := c.pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return ""
+	}
+	owner, st, index := fieldOwner(selection)
+	if st == nil {
+		return ""
+	}
+	return c.sensitive(owner, st, index)
+}
+
+// sensitive reports whether field index of st (declared by owner, which may
+// be nil for anonymous structs) is sensitive, as "Type.Field".
+func (c *checker) sensitive(owner *types.Named, st *types.Struct, index int) string {
+	field := st.Field(index)
+	name := field.Name()
+	if owner != nil {
+		name = owner.Obj().Name() + "." + field.Name()
+	}
+
+	if reflect.StructTag(st.Tag(index)).Get("sensitive") == "true" || c.cfg.fieldNames[field.Name()] {
+		return name
+	}
+	if owner != nil && owner.Obj().Pkg() != nil {
+		pkg := owner.Obj().Pkg()
+		if c.cfg.qualified[pkg.Name()+"."+name] || c.cfg.qualified[pkg.Path()+"."+name] {
+			return name
+		}
+	}
+	return ""
+}
+
+// fieldOwner follows the selection path through embedded fields and returns
+// the struct declaring the selected field and its index.
+func fieldOwner(selection *types.Selection) (*types.Named, *types.Struct, int) {
+	t := selection.Recv()
+	path := selection.Index()
+	for i, index := range path {
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+		named, _ := t.(*types.Named)
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return nil, nil, 0
+		}
+		if i == len(path)-1 {
+			return named, st, index
+		}
+		t = st.Field(index).Type()
+	}
+	return nil, nil, 0
+}
+
+// structOrigin reports whether a value of type t would log a sensitive
+// field when formatted by a slog handler.
+func (c *checker) structOrigin(t types.Type) string {
+	return c.structOriginSeen(t, make(map[types.Type]bool))
+}
+
+func (c *checker) structOriginSeen(t types.Type, seen map[types.Type]bool) string {
+	if t == nil || seen[t] || implementsLogValuer(t) {
+		return ""
+	}
+	seen[t] = true
+	if ptr, ok := t.(*types.Pointer); ok {
+		return c.structOriginSeen(ptr.Elem(), seen)
+	}
+	named, _ := t.(*types.Named)
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return ""
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if !st.Field(i).Exported() {
+			continue
+		}
+		if origin := c.sensitive(named, st, i); origin != "" {
+			return origin
+		}
+		if origin := c.structOriginSeen(st.Field(i).Type(), seen); origin != "" {
+			return origin
+		}
+	}
+	return ""
+}
+
+// implementsLogValuer reports whether t controls its own log representation.
+func implementsLogValuer(t types.Type) bool {
+	for _, typ := range []types.Type{t, types.NewPointer(t)} {
+		obj, _, _ := types.LookupFieldOrMethod(typ, false, nil, "LogValue")
+		fn, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+		sig := fn.Type().(*types.Signature)
+		if sig.Params().Len() == 0 && sig.Results().Len() == 1 {
+			if named, ok := sig.Results().At(0).Type().(*types.Named); ok &&
+				named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "log/slog" && named.Obj().Name() == "Value" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *checker) checkFunc(fn *ast.FuncDecl) {
+	t := c.taintFunc(fn.Body)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			if lc, ok := analysisutil.ParseLogCall(c.pass, node); ok && lc.MsgIndex >= 0 && lc.MsgIndex < len(node.Args) {
+				msg := node.Args[lc.MsgIndex]
+				if origin := c.origin(t, msg); origin != "" {
+					c.pass.Reportf(msg.Pos(), "LINT-041: slog message is derived from sensitive field %s", origin)
+				}
+			}
+			c.checkKeys(t, node)
+		case *ast.CompositeLit:
+			c.checkKeys(t, node)
+		}
+		return true
+	})
+}
+
+func (c *checker) checkKeys(t taint, node ast.Node) {
+	for _, k := range analysisutil.LogKeys(c.pass, node) {
+		if k.IsGroup {
+			continue
+		}
+		if c.sensitiveKey(k.Key) {
+			c.pass.Reportf(k.Expr.Pos(), "LINT-041: slog key %q may contain sensitive data", k.Key)
+			continue
+		}
+		if k.Value == nil {
+			continue
+		}
+		origin := c.origin(t, k.Value)
+		if origin == "" {
+			origin = c.structOrigin(c.pass.TypesInfo.TypeOf(k.Value))
+		}
+		if origin != "" {
+			c.pass.Reportf(k.Value.Pos(), "LINT-041: value logged under %q is derived from sensitive field %s", k.Key, origin)
+		}
+	}
+}
+
+// sensitiveKey reports whether one of the words of key (split on '_', '.',
+// '-' and case changes: apiToken -> api, token) is a configured sensitive
+// word.
+func (c *checker) sensitiveKey(key string) bool {
+	for _, w := range analysisutil.SplitWords(key) {
+		if c.cfg.keyWords[w] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package lint041_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint041"
+)
+
+func testdataDir() string {
+	_, thisFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+}
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, testdataDir(), lint041.Analyzer, "lint041")
+}
+
+func TestAnalyzerCustomConfig(t *testing.T) {
+	if err := lint041.Analyzer.Flags.Set("fields", "lint041custom.User.PhoneNumber"); err != nil {
+		t.Fatalf("failed to set lint041 fields flag: %v", err)
+	}
+	if err := lint041.Analyzer.Flags.Set("keys", "ssn"); err != nil {
+		t.Fatalf("failed to set lint041 keys flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint041.Analyzer.Flags.Set("fields", "Password,Token,Email")
+		_ = lint041.Analyzer.Flags.Set("keys", "password,token,secret")
+	})
+
+	analysistest.Run(t, testdataDir(), lint041.Analyzer, "lint041custom")
+}
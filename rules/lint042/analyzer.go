@@ -0,0 +1,231 @@
+package lint042
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var sourceFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint042",
+	Doc:      "LINT-042: store/service/handler/worker packages must log through an injected or context-derived logger",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&sourceFlag,
+		"source",
+		"any",
+		`where layer packages must get their *slog.Logger from: "field" (injected struct field), "context" (derived from a context.Context), or "any"`,
+	)
+}
+
+// globalFuncs are the package-level log/slog functions that use the default
+// logger.
+var globalFuncs = map[string]bool{
+	"Debug":        true,
+	"Info":         true,
+	"Warn":         true,
+	"Error":        true,
+	"DebugContext": true,
+	"InfoContext":  true,
+	"WarnContext":  true,
+	"ErrorContext": true,
+	"Log":          true,
+	"LogAttrs":     true,
+	"With":         true,
+	"Default":      true,
+}
+
+// loggerSource describes where a *slog.Logger value comes from.
+type loggerSource int
+
+const (
+	sourceUnknown loggerSource = iota
+	sourceField                // struct field, usually injected by a constructor
+	sourceContext              // returned by a call taking a context.Context
+	sourceParam                // function parameter
+	sourceGlobal               // package-level variable
+)
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	pkgName := pass.Pkg.Name()
+	if !strings.Contains(pkgName, "store") &&
+		!strings.Contains(pkgName, "service") &&
+		!strings.Contains(pkgName, "handler") &&
+		!strings.Contains(pkgName, "worker") {
+		return nil, nil
+	}
+
+	switch sourceFlag {
+	case "any", "field", "context":
+	default:
+		return nil, fmt.Errorf("LINT-042: invalid -source %q (want field, context or any)", sourceFlag)
+	}
+	advice := map[string]string{
+		"any":     "an injected *slog.Logger field or a context-derived logger",
+		"field":   "an injected *slog.Logger field",
+		"context": "a context-derived logger",
+	}[sourceFlag]
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		if fn.Body == nil {
+			return
+		}
+		c := newFuncChecker(pass, fn)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !analysisutil.IsSlogCall(pass, call) {
+				return true
+			}
+			sel := call.Fun.(*ast.SelectorExpr)
+
+			if analysisutil.IsPackageSlogCall(pass, call) {
+				if globalFuncs[sel.Sel.Name] {
+					pass.Reportf(call.Pos(), "LINT-042: package-level slog.%s in %s package, use %s", sel.Sel.Name, pkgName, advice)
+				}
+				return true
+			}
+
+			if _, ok := analysisutil.ParseLogCall(pass, call); !ok {
+				return true
+			}
+			switch c.source(sel.X, 0) {
+			case sourceGlobal:
+				pass.Reportf(sel.X.Pos(), "LINT-042: global logger in %s package, use %s", pkgName, advice)
+			case sourceField:
+				if sourceFlag == "context" {
+					pass.Reportf(sel.X.Pos(), "LINT-042: logger from a struct field in %s package, use %s", pkgName, advice)
+				}
+			case sourceContext:
+				if sourceFlag == "field" {
+					pass.Reportf(sel.X.Pos(), "LINT-042: context-derived logger in %s package, use %s", pkgName, advice)
+				}
+			}
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+// funcChecker resolves logger sources within one function.
+type funcChecker struct {
+	pass    *analysis.Pass
+	params  map[*types.Var]bool
+	assigns map[*types.Var][]ast.Expr
+}
+
+func newFuncChecker(pass *analysis.Pass, fn *ast.FuncDecl) *funcChecker {
+	c := &funcChecker{
+		pass:    pass,
+		params:  make(map[*types.Var]bool),
+		assigns: make(map[*types.Var][]ast.Expr),
+	}
+	for _, list := range []*ast.FieldList{fn.Recv, fn.Type.Params} {
+		if list == nil {
+			continue
+		}
+		for _, field := range list.List {
+			for _, name := range field.Names {
+				if v, ok := pass.TypesInfo.Defs[name].(*types.Var); ok {
+					c.params[v] = true
+				}
+			}
+		}
+	}
+
+	record := func(lhs, rhs ast.Expr) {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			return
+		}
+		if v, ok := pass.TypesInfo.ObjectOf(id).(*types.Var); ok {
+			c.assigns[v] = append(c.assigns[v], rhs)
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.AssignStmt:
+			if len(s.Lhs) == len(s.Rhs) {
+				for i := range s.Lhs {
+					record(s.Lhs[i], s.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			if len(s.Names) == len(s.Values) {
+				for i := range s.Names {
+					record(s.Names[i], s.Values[i])
+				}
+			}
+		}
+		return true
+	})
+	return c
+}
+
+// maxDepth bounds the resolution of chains of local assignments.
+const maxDepth = 8
+
+// source classifies the origin of the logger expression expr. Loggers derived
+// with With or WithGroup keep the source of their parent.
+func (c *funcChecker) source(expr ast.Expr, depth int) loggerSource {
+	if depth > maxDepth {
+		return sourceUnknown
+	}
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		v, ok := c.pass.TypesInfo.Uses[e].(*types.Var)
+		if !ok {
+			return sourceUnknown
+		}
+		if v.Parent() == v.Pkg().Scope() {
+			return sourceGlobal
+		}
+		if c.params[v] {
+			return sourceParam
+		}
+		for _, rhs := range c.assigns[v] {
+			if src := c.source(rhs, depth+1); src != sourceUnknown {
+				return src
+			}
+		}
+	case *ast.SelectorExpr:
+		if selection, ok := c.pass.TypesInfo.Selections[e]; ok {
+			if selection.Kind() == types.FieldVal {
+				return sourceField
+			}
+			return sourceUnknown
+		}
+		// Qualified identifier: a variable of another package.
+		if _, ok := c.pass.TypesInfo.Uses[e.Sel].(*types.Var); ok {
+			return sourceGlobal
+		}
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok &&
+			(sel.Sel.Name == "With" || sel.Sel.Name == "WithGroup") &&
+			analysisutil.IsSlogCall(c.pass, e) && !analysisutil.IsPackageSlogCall(c.pass, e) {
+			return c.source(sel.X, depth+1)
+		}
+		for _, arg := range e.Args {
+			if analysisutil.IsContextType(c.pass.TypesInfo.TypeOf(arg)) {
+				return sourceContext
+			}
+		}
+	}
+	return sourceUnknown
+}
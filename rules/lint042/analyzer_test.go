@@ -0,0 +1,40 @@
+package lint042_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint042"
+)
+
+func testdataDir() string {
+	_, thisFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+}
+
+func setSource(t *testing.T, source string) {
+	t.Helper()
+	if err := lint042.Analyzer.Flags.Set("source", source); err != nil {
+		t.Fatalf("failed to set lint042 source flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint042.Analyzer.Flags.Set("source", "any")
+	})
+}
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, testdataDir(), lint042.Analyzer, "lint042service", "lint042other")
+}
+
+func TestAnalyzerFieldSource(t *testing.T) {
+	setSource(t, "field")
+	analysistest.Run(t, testdataDir(), lint042.Analyzer, "lint042store")
+}
+
+func TestAnalyzerContextSource(t *testing.T) {
+	setSource(t, "context")
+	analysistest.Run(t, testdataDir(), lint042.Analyzer, "lint042handler")
+}
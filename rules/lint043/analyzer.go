@@ -0,0 +1,392 @@
+package lint043
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:     "lint043",
+	Doc:      "LINT-043: store not-found errors must be translated to types.Err* sentinels before leaving the store",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	pkgName := pass.Pkg.Name()
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	switch {
+	case strings.Contains(pkgName, "store"):
+		insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+			fn := n.(*ast.FuncDecl)
+			if fn.Body != nil && isStoreMethod(fn) {
+				checkStoreMethod(pass, fn)
+			}
+		})
+	case strings.Contains(pkgName, "service") || strings.Contains(pkgName, "handler"):
+		insp.Preorder([]ast.Node{(*ast.CallExpr)(nil), (*ast.BinaryExpr)(nil)}, func(n ast.Node) {
+			sentinel, ok := notFoundCheck(pass, n, nil)
+			if ok {
+				pass.Reportf(n.Pos(), "LINT-043: %s check against %s in %s package leaks the store layer, check a types.Err* sentinel instead", checkKind(n), sentinel, pkgName)
+			}
+		})
+	}
+
+	return nil, nil
+}
+
+func checkKind(n ast.Node) string {
+	if bin, ok := n.(*ast.BinaryExpr); ok {
+		return bin.Op.String()
+	}
+	return "errors.Is"
+}
+
+// isStoreMethod reports whether fn is a method on a *{Name}Store receiver.
+func isStoreMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return false
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	return ok && strings.HasSuffix(ident.Name, "Store")
+}
+
+// isDriverPackage reports whether path is a database driver whose lookups
+// report missing rows with a not-found sentinel.
+func isDriverPackage(path string) bool {
+	return path == "database/sql" ||
+		path == "gorm.io/gorm" ||
+		path == "github.com/jinzhu/gorm" ||
+		strings.HasPrefix(path, "github.com/jackc/pgx")
+}
+
+// gormLookups are the *gorm.DB methods returning ErrRecordNotFound.
+var gormLookups = map[string]bool{"First": true, "Take": true, "Last": true}
+
+// pgxLookups are the pgx functions returning ErrNoRows.
+var pgxLookups = map[string]bool{"CollectOneRow": true, "CollectExactlyOneRow": true}
+
+// flow tracks, within one store method, the error variables holding a
+// not-found error and the driver call it originates from.
+type flow struct {
+	pass    *analysis.Pass
+	origins map[*types.Var]string
+}
+
+func checkStoreMethod(pass *analysis.Pass, fn *ast.FuncDecl) {
+	f := &flow{pass: pass, origins: make(map[*types.Var]string)}
+	f.propagate(fn.Body)
+	translated := f.translated(fn.Body)
+
+	inspectBody(fn.Body, func(n ast.Node) {
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok {
+			return
+		}
+		for _, result := range ret.Results {
+			origin, v := f.origin(result)
+			if origin == "" || v != nil && translated[v] {
+				continue
+			}
+			pass.Reportf(result.Pos(), "LINT-043: not-found error from %s escapes %s without translation to a types.Err* sentinel", origin, fn.Name.Name)
+		}
+	})
+}
+
+// inspectBody visits the nodes of body, skipping function literals.
+func inspectBody(body *ast.BlockStmt, visit func(ast.Node)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+		if n != nil {
+			visit(n)
+		}
+		return true
+	})
+}
+
+// propagate marks error variables assigned from not-found sources until no
+// new variable is marked. The analysis is flow-insensitive.
+func (f *flow) propagate(body *ast.BlockStmt) {
+	for changed := true; changed; {
+		changed = false
+		mark := func(lhs ast.Expr, origin string) {
+			id, ok := lhs.(*ast.Ident)
+			if !ok || origin == "" {
+				return
+			}
+			v, ok := f.pass.TypesInfo.ObjectOf(id).(*types.Var)
+			if !ok || f.origins[v] != "" || !analysisutil.IsErrorType(v.Type()) {
+				return
+			}
+			f.origins[v] = origin
+			changed = true
+		}
+		inspectBody(body, func(n ast.Node) {
+			var lhs, rhs []ast.Expr
+			switch s := n.(type) {
+			case *ast.AssignStmt:
+				lhs, rhs = s.Lhs, s.Rhs
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					lhs = append(lhs, name)
+				}
+				rhs = s.Values
+			default:
+				return
+			}
+			switch {
+			case len(lhs) == len(rhs):
+				for i := range lhs {
+					origin, _ := f.origin(rhs[i])
+					mark(lhs[i], origin)
+				}
+			case len(rhs) == 1:
+				// u, err := pgx.CollectOneRow(...): the error result carries the origin.
+				origin, _ := f.origin(rhs[0])
+				for _, l := range lhs {
+					mark(l, origin)
+				}
+			}
+		})
+	}
+}
+
+// origin returns the driver lookup expr is derived from, and the variable the
+// error flowed through (nil when expr is the lookup itself).
+func (f *flow) origin(expr ast.Expr) (string, *types.Var) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		if v, ok := f.pass.TypesInfo.Uses[e].(*types.Var); ok && f.origins[v] != "" {
+			return f.origins[v], v
+		}
+	case *ast.SelectorExpr:
+		// db.Where(...).First(&u).Error
+		if e.Sel.Name == "Error" && isGormDB(f.pass.TypesInfo.TypeOf(e.X)) {
+			if lookup := gormLookup(f.pass, e.X); lookup != "" {
+				return "gorm " + lookup, nil
+			}
+		}
+	case *ast.CallExpr:
+		if origin := f.lookupCall(e); origin != "" {
+			return origin, nil
+		}
+		// fmt.Errorf("...: %w", err) and errors.Join(err, ...) keep the sentinel.
+		fn := analysisutil.CalledFunc(f.pass, e)
+		if fn == nil || fn.Pkg() == nil {
+			return "", nil
+		}
+		switch fn.Pkg().Path() + "." + fn.Name() {
+		case "fmt.Errorf":
+			if len(e.Args) == 0 || !wraps(f.pass, e.Args[0]) {
+				return "", nil
+			}
+			for _, arg := range e.Args[1:] {
+				if origin, v := f.origin(arg); origin != "" {
+					return origin, v
+				}
+			}
+		case "errors.Join":
+			for _, arg := range e.Args {
+				if origin, v := f.origin(arg); origin != "" {
+					return origin, v
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// lookupCall returns the driver lookup performed by call: Row.Scan for
+// database/sql and pgx, and pgx.CollectOneRow/CollectExactlyOneRow.
+func (f *flow) lookupCall(call *ast.CallExpr) string {
+	fn := analysisutil.CalledFunc(f.pass, call)
+	if fn == nil || fn.Pkg() == nil || !isDriverPackage(fn.Pkg().Path()) {
+		return ""
+	}
+	pkg := fn.Pkg().Name()
+	sig := fn.Type().(*types.Signature)
+	if recv := sig.Recv(); recv != nil {
+		if fn.Name() == "Scan" && typeName(recv.Type()) == "Row" {
+			return pkg + " Row.Scan"
+		}
+		return ""
+	}
+	if pkg == "pgx" && pgxLookups[fn.Name()] {
+		return "pgx " + fn.Name()
+	}
+	return ""
+}
+
+// gormLookup returns the gorm lookup method (First, Take, Last) found in the
+// method chain expr.
+func gormLookup(pass *analysis.Pass, expr ast.Expr) string {
+	for {
+		call, ok := ast.Unparen(expr).(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return ""
+		}
+		if gormLookups[sel.Sel.Name] && isGormDB(pass.TypesInfo.TypeOf(sel.X)) {
+			return sel.Sel.Name
+		}
+		expr = sel.X
+	}
+}
+
+// translated returns the variables checked against a not-found sentinel in
+// an if or switch case whose body refers to a types.Err* sentinel.
+func (f *flow) translated(body *ast.BlockStmt) map[*types.Var]bool {
+	out := make(map[*types.Var]bool)
+	check := func(conds []ast.Expr, stmts []ast.Stmt) {
+		if !refersToDomainError(f.pass, stmts) {
+			return
+		}
+		for _, cond := range conds {
+			ast.Inspect(cond, func(n ast.Node) bool {
+				var v *types.Var
+				if _, ok := notFoundCheck(f.pass, n, &v); ok && v != nil {
+					out[v] = true
+				}
+				return true
+			})
+		}
+	}
+	inspectBody(body, func(n ast.Node) {
+		switch s := n.(type) {
+		case *ast.IfStmt:
+			check([]ast.Expr{s.Cond}, s.Body.List)
+		case *ast.CaseClause:
+			check(s.List, s.Body)
+		}
+	})
+	return out
+}
+
+// notFoundCheck reports whether n is errors.Is(x, sentinel), x == sentinel
+// or x != sentinel for a driver not-found sentinel. When checked is non-nil it
+// receives the variable x, if any.
+func notFoundCheck(pass *analysis.Pass, n ast.Node, checked **types.Var) (string, bool) {
+	var x, target ast.Expr
+	switch e := n.(type) {
+	case *ast.CallExpr:
+		fn := analysisutil.CalledFunc(pass, e)
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != "errors" || fn.Name() != "Is" || len(e.Args) != 2 {
+			return "", false
+		}
+		x, target = e.Args[0], e.Args[1]
+	case *ast.BinaryExpr:
+		if e.Op != token.EQL && e.Op != token.NEQ {
+			return "", false
+		}
+		x, target = e.X, e.Y
+		if _, ok := notFoundSentinel(pass, x); ok {
+			x, target = e.Y, e.X
+		}
+	default:
+		return "", false
+	}
+
+	sentinel, ok := notFoundSentinel(pass, target)
+	if !ok {
+		return "", false
+	}
+	if checked != nil {
+		if id, ok := ast.Unparen(x).(*ast.Ident); ok {
+			*checked, _ = pass.TypesInfo.Uses[id].(*types.Var)
+		}
+	}
+	return sentinel, true
+}
+
+// notFoundSentinel reports whether expr is sql.ErrNoRows, pgx.ErrNoRows or
+// gorm.ErrRecordNotFound.
+func notFoundSentinel(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	case *ast.Ident:
+		ident = e
+	default:
+		return "", false
+	}
+	v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || v.Pkg() == nil || !isDriverPackage(v.Pkg().Path()) {
+		return "", false
+	}
+	if v.Name() != "ErrNoRows" && v.Name() != "ErrRecordNotFound" {
+		return "", false
+	}
+	return v.Pkg().Name() + "." + v.Name(), true
+}
+
+// refersToDomainError reports whether stmts use an Err* identifier of a
+// package named types.
+func refersToDomainError(pass *analysis.Pass, stmts []ast.Stmt) bool {
+	found := false
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			id, ok := n.(*ast.Ident)
+			if !ok || found {
+				return !found
+			}
+			obj := pass.TypesInfo.Uses[id]
+			if obj != nil && obj.Pkg() != nil && obj.Pkg().Name() == "types" && strings.HasPrefix(obj.Name(), "Err") {
+				found = true
+			}
+			return true
+		})
+	}
+	return found
+}
+
+// wraps reports whether the constant format string contains %w.
+func wraps(pass *analysis.Pass, format ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[format]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return false
+	}
+	return strings.Contains(constant.StringVal(tv.Value), "%w")
+}
+
+func isGormDB(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Name() == "DB" &&
+		(obj.Pkg().Path() == "gorm.io/gorm" || obj.Pkg().Path() == "github.com/jinzhu/gorm")
+}
+
+func typeName(t types.Type) string {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return ""
+}
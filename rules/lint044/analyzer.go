@@ -0,0 +1,283 @@
+package lint044
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	constructorsFlag string
+	getPrefixesFlag  string
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint044",
+	Doc:       "LINT-044: types/errors.go sentinels must be well-formed, unique, complete and used",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*errorsFact)(nil)},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&constructorsFlag,
+		"constructors",
+		"",
+		`comma-separated domain-error constructors allowed besides errors.New, as "pkg.Func" (package name or import path)`,
+	)
+	Analyzer.Flags.StringVar(
+		&getPrefixesFlag,
+		"get-prefixes",
+		"Get",
+		"comma-separated store method name prefixes that require a not-found sentinel",
+	)
+}
+
+// errorsFact summarizes the sentinels a package declares in types/errors.go
+// and the types.Err* sentinels it references.
+type errorsFact struct {
+	Sentinels []sentinel
+	Refs      []string
+}
+
+type sentinel struct {
+	Key     string
+	Message string
+}
+
+func (*errorsFact) AFact() {}
+
+func (f *errorsFact) String() string {
+	return "errors"
+}
+
+// trailingPunctuation are the characters an error message must not end with.
+const trailingPunctuation = ".!?:;,"
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	fact := &errorsFact{Refs: referencedSentinels(pass)}
+	if pass.Pkg.Name() == "types" {
+		fact.Sentinels = checkErrorsFile(pass, analysisutil.SplitList(constructorsFlag))
+		checkNotFoundSentinels(pass, fact.Sentinels, analysisutil.SplitList(getPrefixesFlag))
+	}
+	if len(fact.Sentinels) > 0 || len(fact.Refs) > 0 {
+		pass.ExportPackageFact(fact)
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportModule(pass)
+	}
+
+	return nil, nil
+}
+
+// checkErrorsFile checks the Err* declarations of errors.go and returns them.
+func checkErrorsFile(pass *analysis.Pass, constructors []string) []sentinel {
+	var out []sentinel
+	seen := make(map[string]string)
+	for _, f := range pass.Files {
+		if analysisutil.FileBasename(pass, f.Pos()) != "errors.go" {
+			continue
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if !strings.HasPrefix(name.Name, "Err") {
+						continue
+					}
+					var value ast.Expr
+					if i < len(vs.Values) {
+						value = vs.Values[i]
+					}
+					msg := checkSentinel(pass, name, value, constructors)
+					if other, dup := seen[msg]; dup {
+						pass.Reportf(name.Pos(), "LINT-044: error message %q of %q is already used by %q", msg, name.Name, other)
+					} else if msg != "" {
+						seen[msg] = name.Name
+					}
+					out = append(out, sentinel{Key: pass.Pkg.Path() + "." + name.Name, Message: msg})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// checkSentinel checks how the sentinel is built and its message, and returns
+// the message, or "" when it is not a constant.
+func checkSentinel(pass *analysis.Pass, name *ast.Ident, value ast.Expr, constructors []string) string {
+	call, ok := ast.Unparen(value).(*ast.CallExpr)
+	if !ok || !isAllowedConstructor(pass, call, constructors) {
+		pass.Reportf(name.Pos(), "LINT-044: %q must be built with errors.New or a configured domain-error constructor", name.Name)
+		return ""
+	}
+
+	for _, arg := range call.Args {
+		msg, ok := analysisutil.ConstantString(pass, arg)
+		if !ok {
+			continue
+		}
+		checkMessage(pass, name.Name, arg, msg)
+		return msg
+	}
+	return ""
+}
+
+func checkMessage(pass *analysis.Pass, name string, arg ast.Expr, msg string) {
+	fixed := strings.TrimRight(msg, trailingPunctuation)
+	if r, size := utf8.DecodeRuneInString(fixed); unicode.IsUpper(r) && !isAcronym(fixed) {
+		fixed = string(unicode.ToLower(r)) + fixed[size:]
+	}
+	if fixed == msg {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     arg.Pos(),
+		Message: fmt.Sprintf("LINT-044: message of %q must be lowercase without trailing punctuation", name),
+	}
+	if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: fmt.Sprintf("Use %q", fixed),
+			TextEdits: []analysis.TextEdit{
+				{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(fixed))},
+			},
+		}}
+	}
+	pass.Report(diag)
+}
+
+// isAcronym reports whether the first word of s is all upper case (JWT, ID).
+func isAcronym(s string) bool {
+	word, _, _ := strings.Cut(s, " ")
+	if utf8.RuneCountInString(word) < 2 {
+		return false
+	}
+	return strings.ToUpper(word) == word
+}
+
+func isAllowedConstructor(pass *analysis.Pass, call *ast.CallExpr, constructors []string) bool {
+	fn := analysisutil.CalledFunc(pass, call)
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
+	if fn.Pkg().Path() == "errors" && fn.Name() == "New" {
+		return true
+	}
+	for _, c := range constructors {
+		if c == fn.Pkg().Path()+"."+fn.Name() || c == fn.Pkg().Name()+"."+fn.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNotFoundSentinels requires an Err{Entity}NotFound sentinel in
+// errors.go for every {Entity}Store interface with get methods.
+func checkNotFoundSentinels(pass *analysis.Pass, sentinels []sentinel, getPrefixes []string) {
+	declared := make(map[string]bool)
+	for _, s := range sentinels {
+		declared[s.Key] = true
+	}
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !strings.HasSuffix(name, "Store") || name == "Store" {
+			continue
+		}
+		iface, ok := tn.Type().Underlying().(*types.Interface)
+		if !ok || !hasGetMethod(iface, getPrefixes) {
+			continue
+		}
+		want := "Err" + strings.TrimSuffix(name, "Store") + "NotFound"
+		if !declared[pass.Pkg.Path()+"."+want] {
+			pass.Reportf(tn.Pos(), "LINT-044: store interface %q has get methods but no %q sentinel in errors.go", name, want)
+		}
+	}
+}
+
+func hasGetMethod(iface *types.Interface, prefixes []string) bool {
+	for i := 0; i < iface.NumMethods(); i++ {
+		if analysisutil.HasVerbPrefix(iface.Method(i).Name(), prefixes) {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedSentinels returns the Err* variables of types packages used in
+// this package, excluding their declarations.
+func referencedSentinels(pass *analysis.Pass) []string {
+	seen := make(map[string]bool)
+	for _, obj := range pass.TypesInfo.Uses {
+		v, ok := obj.(*types.Var)
+		if !ok || v.Pkg() == nil || v.Pkg().Name() != "types" || !strings.HasPrefix(v.Name(), "Err") {
+			continue
+		}
+		if v.Parent() != v.Pkg().Scope() {
+			continue
+		}
+		seen[v.Pkg().Path()+"."+v.Name()] = true
+	}
+
+	out := make([]string, 0, len(seen))
+	for k := range seen {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// reportModule cross-references the facts of every package reachable from a
+// main package: duplicate messages across types packages and unreferenced
+// sentinels.
+func reportModule(pass *analysis.Pass) {
+	var sentinels []sentinel
+	referenced := make(map[string]bool)
+	for _, pf := range pass.AllPackageFacts() {
+		fact, ok := pf.Fact.(*errorsFact)
+		if !ok {
+			continue
+		}
+		sentinels = append(sentinels, fact.Sentinels...)
+		for _, r := range fact.Refs {
+			referenced[r] = true
+		}
+	}
+	if len(sentinels) == 0 {
+		return
+	}
+
+	sort.Slice(sentinels, func(i, j int) bool { return sentinels[i].Key < sentinels[j].Key })
+	pos := analysisutil.MainReportPos(pass)
+	byMessage := make(map[string]string)
+	for _, s := range sentinels {
+		if other, dup := byMessage[s.Message]; dup && pkgOf(other) != pkgOf(s.Key) {
+			pass.Reportf(pos, "LINT-044: error message %q is used by both %q and %q", s.Message, other, s.Key)
+		} else if !dup && s.Message != "" {
+			byMessage[s.Message] = s.Key
+		}
+		if !referenced[s.Key] {
+			pass.Reportf(pos, "LINT-044: error sentinel %q is never referenced in the module", s.Key)
+		}
+	}
+}
+
+func pkgOf(key string) string {
+	return key[:strings.LastIndex(key, ".")]
+}
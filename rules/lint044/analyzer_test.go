@@ -0,0 +1,25 @@
+package lint044_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint044"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	if err := lint044.Analyzer.Flags.Set("constructors", "apperr.New"); err != nil {
+		t.Fatalf("failed to set lint044 constructors flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint044.Analyzer.Flags.Set("constructors", "")
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdata, lint044.Analyzer, "lint044/types", "lint044/billing/types", "lint044/userstore", "lint044/cmd")
+}
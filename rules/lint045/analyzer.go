@@ -0,0 +1,204 @@
+package lint045
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var mapperFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint045",
+	Doc:       "LINT-045: every types.Err* sentinel must be handled by the error-to-HTTP-status mapper",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*errorMappingFact)(nil)},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&mapperFlag,
+		"mapper",
+		"handler.errorStatus",
+		`function or map variable translating errors to HTTP statuses, as "pkg.name" (package name or import path)`,
+	)
+}
+
+// errorMappingFact records the sentinels a types package declares in
+// errors.go, or the sentinels handled by the mapper declared in a package.
+type errorMappingFact struct {
+	Sentinels []string
+	// Mapper reports whether the package declares the configured mapper.
+	Mapper  bool
+	Handled []string
+}
+
+func (*errorMappingFact) AFact() {}
+
+func (f *errorMappingFact) String() string {
+	return "errorMapping"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	fact := &errorMappingFact{}
+	if pass.Pkg.Name() == "types" {
+		fact.Sentinels = declaredSentinels(pass)
+	}
+	if decl := findMapper(pass); decl != nil {
+		fact.Mapper = true
+		fact.Handled = checkMapper(pass, decl)
+	}
+	if len(fact.Sentinels) > 0 || fact.Mapper {
+		pass.ExportPackageFact(fact)
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportUnhandled(pass)
+	}
+
+	return nil, nil
+}
+
+// declaredSentinels returns the keys of the Err* variables of errors.go.
+func declaredSentinels(pass *analysis.Pass) []string {
+	var out []string
+	for _, f := range pass.Files {
+		if analysisutil.FileBasename(pass, f.Pos()) != "errors.go" {
+			continue
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if strings.HasPrefix(name.Name, "Err") {
+						out = append(out, pass.Pkg.Path()+"."+name.Name)
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// findMapper returns the declaration of the configured mapper when it lives
+// in the current package.
+func findMapper(pass *analysis.Pass) ast.Node {
+	i := strings.LastIndex(mapperFlag, ".")
+	if i < 0 {
+		return nil
+	}
+	pkg, name := mapperFlag[:i], mapperFlag[i+1:]
+	if pkg != pass.Pkg.Name() && pkg != pass.Pkg.Path() {
+		return nil
+	}
+
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Name.Name == name {
+					return d
+				}
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					vs, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+					for _, n := range vs.Names {
+						if n.Name == name {
+							return vs
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkMapper returns the sentinels referenced by the mapper and reports
+// entries that are not sentinels declared in a types/errors.go.
+func checkMapper(pass *analysis.Pass, decl ast.Node) []string {
+	seen := make(map[string]bool)
+	ast.Inspect(decl, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+		if !ok || v.Pkg() == nil || v.Parent() != v.Pkg().Scope() || !strings.HasPrefix(v.Name(), "Err") {
+			return true
+		}
+
+		key := v.Pkg().Path() + "." + v.Name()
+		if !isDeclaredSentinel(pass, v, key) {
+			pass.Reportf(ident.Pos(), "LINT-045: mapper entry %s.%s is not a sentinel declared in types/errors.go", v.Pkg().Name(), v.Name())
+			return true
+		}
+		seen[key] = true
+		return true
+	})
+
+	out := make([]string, 0, len(seen))
+	for k := range seen {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func isDeclaredSentinel(pass *analysis.Pass, v *types.Var, key string) bool {
+	var fact errorMappingFact
+	if v.Pkg() == pass.Pkg || !pass.ImportPackageFact(v.Pkg(), &fact) {
+		return false
+	}
+	for _, s := range fact.Sentinels {
+		if s == key {
+			return true
+		}
+	}
+	return false
+}
+
+// reportUnhandled reports, on func main, the sentinels of every package
+// reachable from the main package that the mapper does not handle. Nothing is
+// reported when the mapper is not reachable.
+func reportUnhandled(pass *analysis.Pass) {
+	var sentinels []string
+	handled := make(map[string]bool)
+	mapperFound := false
+	for _, pf := range pass.AllPackageFacts() {
+		fact, ok := pf.Fact.(*errorMappingFact)
+		if !ok {
+			continue
+		}
+		sentinels = append(sentinels, fact.Sentinels...)
+		if fact.Mapper {
+			mapperFound = true
+		}
+		for _, h := range fact.Handled {
+			handled[h] = true
+		}
+	}
+	if !mapperFound {
+		return
+	}
+
+	sort.Strings(sentinels)
+	pos := analysisutil.MainReportPos(pass)
+	for _, s := range sentinels {
+		if !handled[s] {
+			pass.Reportf(pos, "LINT-045: sentinel %q is not handled by %s", s, mapperFlag)
+		}
+	}
+}
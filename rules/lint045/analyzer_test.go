@@ -0,0 +1,31 @@
+package lint045_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint045"
+)
+
+func testdataDir() string {
+	_, thisFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+}
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, testdataDir(), lint045.Analyzer, "lint045/types", "lint045/billing/types", "lint045/handler", "lint045/cmd")
+}
+
+func TestAnalyzerMapVariable(t *testing.T) {
+	if err := lint045.Analyzer.Flags.Set("mapper", "lint045/apihandler.statusByError"); err != nil {
+		t.Fatalf("failed to set lint045 mapper flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint045.Analyzer.Flags.Set("mapper", "handler.errorStatus")
+	})
+
+	analysistest.Run(t, testdataDir(), lint045.Analyzer, "lint045/apihandler")
+}
@@ -0,0 +1,73 @@
+package lint046
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint046",
+	Doc:  "LINT-046: route pattern params and Input path tags must match",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		if route.Input == nil {
+			continue
+		}
+		checkRoute(pass, route)
+	}
+
+	return nil, nil
+}
+
+func checkRoute(pass *analysis.Pass, route analysisutil.Route) {
+	inputName := route.Input.Obj().Name()
+	fields := analysisutil.TaggedFields(route.Input, "path")
+	params := analysisutil.PathParams(route.Path)
+
+	tags := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		tags[f.Name] = true
+	}
+	inPattern := make(map[string]bool, len(params))
+	for _, p := range params {
+		inPattern[p] = true
+	}
+
+	for _, p := range params {
+		if tags[p] {
+			continue
+		}
+		msg := fmt.Sprintf("LINT-046: path param %q of route %q has no matching path:%q field in %s", p, route.Path, p, inputName)
+		if near := caseInsensitiveMatch(p, fields); near != "" {
+			msg += fmt.Sprintf(" (found path:%q, which differs only in case)", near)
+		}
+		pass.Reportf(route.Pattern.Pos(), "%s", msg)
+	}
+
+	for _, f := range fields {
+		if inPattern[f.Name] {
+			continue
+		}
+		pos := f.Field.Pos()
+		if !analysisutil.ContainsPos(pass, pos) {
+			pos = route.Pattern.Pos()
+		}
+		pass.Reportf(pos, "LINT-046: field %s.%s has path tag %q that does not appear in route %q", inputName, f.Field.Name(), f.Name, route.Path)
+	}
+}
+
+func caseInsensitiveMatch(param string, fields []analysisutil.TaggedField) string {
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, param) {
+			return f.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,401 @@
+package lint047
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+	"gopkg.in/yaml.v3"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var specFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint047",
+	Doc:       "LINT-047: registered routes must match the checked-in OpenAPI document",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*routesFact)(nil)},
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&specFlag,
+		"spec",
+		"",
+		"path to the OpenAPI document (.yaml, .yml or .json), relative to the module root (rule disabled when empty)",
+	)
+}
+
+// routesFact records the routes ("METHOD /path") registered in a package.
+type routesFact struct {
+	Routes []string
+}
+
+func (*routesFact) AFact() {}
+
+func (f *routesFact) String() string {
+	return "routes"
+}
+
+// document is the subset of an OpenAPI 3 document the rule reads. Only local
+// references (#/components/...) are resolved.
+type document struct {
+	Paths      map[string]*pathItem `json:"paths" yaml:"paths"`
+	Components struct {
+		Schemas       map[string]*schema  `json:"schemas" yaml:"schemas"`
+		RequestBodies map[string]*content `json:"requestBodies" yaml:"requestBodies"`
+		Responses     map[string]*content `json:"responses" yaml:"responses"`
+	} `json:"components" yaml:"components"`
+}
+
+type pathItem struct {
+	Get     *operation `json:"get" yaml:"get"`
+	Put     *operation `json:"put" yaml:"put"`
+	Post    *operation `json:"post" yaml:"post"`
+	Delete  *operation `json:"delete" yaml:"delete"`
+	Options *operation `json:"options" yaml:"options"`
+	Head    *operation `json:"head" yaml:"head"`
+	Patch   *operation `json:"patch" yaml:"patch"`
+	Trace   *operation `json:"trace" yaml:"trace"`
+}
+
+type operation struct {
+	RequestBody *content            `json:"requestBody" yaml:"requestBody"`
+	Responses   map[string]*content `json:"responses" yaml:"responses"`
+}
+
+// content is a request body or a response.
+type content struct {
+	Ref     string                `json:"$ref" yaml:"$ref"`
+	Content map[string]*mediaType `json:"content" yaml:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema" yaml:"schema"`
+}
+
+type schema struct {
+	Ref        string             `json:"$ref" yaml:"$ref"`
+	Properties map[string]*schema `json:"properties" yaml:"properties"`
+	AllOf      []*schema          `json:"allOf" yaml:"allOf"`
+}
+
+// operations returns the operations of item by upper-case method.
+func (item *pathItem) operations() map[string]*operation {
+	out := make(map[string]*operation)
+	for method, op := range map[string]*operation{
+		"GET": item.Get, "PUT": item.Put, "POST": item.Post, "DELETE": item.Delete,
+		"OPTIONS": item.Options, "HEAD": item.Head, "PATCH": item.Patch, "TRACE": item.Trace,
+	} {
+		if op != nil {
+			out[method] = op
+		}
+	}
+	return out
+}
+
+var documentCache sync.Map // path -> *document
+
+func loadDocument(path string) (*document, error) {
+	if cached, ok := documentCache.Load(path); ok {
+		return cached.(*document), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LINT-047: reading OpenAPI document: %w", err)
+	}
+	var doc document
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &doc)
+	} else {
+		err = yaml.Unmarshal(data, &doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LINT-047: parsing OpenAPI document %s: %w", path, err)
+	}
+
+	documentCache.Store(path, &doc)
+	return &doc, nil
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if specFlag == "" {
+		return nil, nil
+	}
+	doc, err := loadDocument(analysisutil.ResolveModulePath(pass, specFlag))
+	if err != nil {
+		return nil, err
+	}
+
+	fact := &routesFact{}
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		fact.Routes = append(fact.Routes, strings.TrimSpace(route.Method+" "+route.Path))
+		checkRoute(pass, doc, route)
+	}
+	if len(fact.Routes) > 0 {
+		pass.ExportPackageFact(fact)
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportUnimplemented(pass, doc, fact.Routes)
+	}
+
+	return nil, nil
+}
+
+// templateKey returns path with its parameter names removed, so that
+// /users/{id} and /users/{userId} compare equal.
+func templateKey(path string) string {
+	var b strings.Builder
+	for {
+		open := strings.IndexByte(path, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(path[open:], '}')
+		if end < 0 {
+			break
+		}
+		b.WriteString(path[:open] + "{}")
+		path = path[open+end+1:]
+	}
+	b.WriteString(path)
+	return b.String()
+}
+
+// findPath returns the documented path matching the template of path.
+func findPath(doc *document, path string) (string, *pathItem) {
+	key := templateKey(path)
+	docPaths := make([]string, 0, len(doc.Paths))
+	for p := range doc.Paths {
+		docPaths = append(docPaths, p)
+	}
+	sort.Strings(docPaths)
+	for _, p := range docPaths {
+		if templateKey(p) == key && doc.Paths[p] != nil {
+			return p, doc.Paths[p]
+		}
+	}
+	return "", nil
+}
+
+func checkRoute(pass *analysis.Pass, doc *document, route analysisutil.Route) {
+	name := strings.TrimSpace(route.Method + " " + route.Path)
+	docPath, item := findPath(doc, route.Path)
+	if item == nil {
+		pass.Reportf(route.Pattern.Pos(), "LINT-047: route %q is not documented in %s", name, filepath.Base(specFlag))
+		return
+	}
+	ops := item.operations()
+	op := ops[route.Method]
+	if route.Method == "" {
+		if len(ops) == 0 {
+			pass.Reportf(route.Pattern.Pos(), "LINT-047: route %q is not documented in %s", name, filepath.Base(specFlag))
+		}
+		return
+	}
+	if op == nil {
+		pass.Reportf(route.Pattern.Pos(), "LINT-047: route %q is not documented in %s", name, filepath.Base(specFlag))
+		return
+	}
+
+	docParams := analysisutil.PathParams(docPath)
+	for i, p := range analysisutil.PathParams(route.Path) {
+		if i < len(docParams) && docParams[i] != p {
+			pass.Reportf(route.Pattern.Pos(), "LINT-047: path param %q of route %q is named %q in the OpenAPI document", p, name, docParams[i])
+		}
+	}
+
+	if route.Input != nil && op.RequestBody != nil {
+		checkBody(pass, doc, route, name, "request", route.Input, op.RequestBody)
+	}
+	if route.Output != nil {
+		if resp := successResponse(op); resp != nil {
+			checkBody(pass, doc, route, name, "response", route.Output, resp)
+		}
+	}
+}
+
+// successResponse returns the response of the lowest 2xx status code.
+func successResponse(op *operation) *content {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+	sort.Strings(codes)
+	return op.Responses[codes[0]]
+}
+
+// checkBody compares the json fields of the body of payload with the
+// properties of the JSON schema of c. Schemas whose properties cannot be
+// resolved locally are skipped.
+func checkBody(pass *analysis.Pass, doc *document, route analysisutil.Route, name, kind string, payload *types.Named, c *content) {
+	c = resolveContent(doc, c, kind)
+	if c == nil {
+		return
+	}
+	var s *schema
+	for _, mt := range sortedMediaTypes(c) {
+		if mt == "application/json" || strings.HasSuffix(mt, "+json") {
+			s = c.Content[mt].Schema
+			break
+		}
+	}
+	props, ok := properties(doc, s, 0)
+	if !ok {
+		return
+	}
+
+	body, fields := analysisutil.PayloadBody(payload)
+	typeName := payload.Obj().Name()
+	if body != nil {
+		typeName = types.TypeString(body.Type(), types.RelativeTo(pass.Pkg))
+	}
+
+	inGo := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		inGo[f.Name] = true
+		if props[f.Name] {
+			continue
+		}
+		pos := f.Field.Pos()
+		if !analysisutil.ContainsPos(pass, pos) {
+			pos = route.Pattern.Pos()
+		}
+		pass.Reportf(pos, "LINT-047: json field %q of %s is not a property of the OpenAPI %s schema of %q", f.Name, typeName, kind, name)
+	}
+
+	missing := make([]string, 0, len(props))
+	for p := range props {
+		if !inGo[p] {
+			missing = append(missing, p)
+		}
+	}
+	sort.Strings(missing)
+	for _, p := range missing {
+		pass.Reportf(route.Pattern.Pos(), "LINT-047: property %q of the OpenAPI %s schema of %q has no json field in %s", p, kind, name, typeName)
+	}
+}
+
+func resolveContent(doc *document, c *content, kind string) *content {
+	for depth := 0; c != nil && c.Ref != ""; depth++ {
+		if depth > 8 {
+			return nil
+		}
+		components := doc.Components.Responses
+		prefix := "#/components/responses/"
+		if kind == "request" {
+			components, prefix = doc.Components.RequestBodies, "#/components/requestBodies/"
+		}
+		if !strings.HasPrefix(c.Ref, prefix) {
+			return nil
+		}
+		c = components[strings.TrimPrefix(c.Ref, prefix)]
+	}
+	return c
+}
+
+func sortedMediaTypes(c *content) []string {
+	out := make([]string, 0, len(c.Content))
+	for mt := range c.Content {
+		if c.Content[mt] != nil {
+			out = append(out, mt)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// properties returns the property names of s, following local $ref and
+// allOf. ok is false when s is not an object schema that can be resolved.
+func properties(doc *document, s *schema, depth int) (props map[string]bool, ok bool) {
+	if s == nil || depth > 8 {
+		return nil, false
+	}
+	if s.Ref != "" {
+		const prefix = "#/components/schemas/"
+		if !strings.HasPrefix(s.Ref, prefix) {
+			return nil, false
+		}
+		return properties(doc, doc.Components.Schemas[strings.TrimPrefix(s.Ref, prefix)], depth+1)
+	}
+	if s.Properties == nil && len(s.AllOf) == 0 {
+		return nil, false
+	}
+
+	props = make(map[string]bool, len(s.Properties))
+	for p := range s.Properties {
+		props[p] = true
+	}
+	for _, sub := range s.AllOf {
+		subProps, ok := properties(doc, sub, depth+1)
+		if !ok {
+			return nil, false
+		}
+		for p := range subProps {
+			props[p] = true
+		}
+	}
+	return props, true
+}
+
+// reportUnimplemented reports, on func main, the documented operations that
+// no route reachable from the main package implements. Nothing is reported
+// when no route is reachable.
+func reportUnimplemented(pass *analysis.Pass, doc *document, local []string) {
+	implemented := make(map[string]bool)
+	add := func(route string) {
+		method, path := analysisutil.SplitPattern(route)
+		implemented[method+" "+templateKey(path)] = true
+	}
+	for _, r := range local {
+		add(r)
+	}
+	for _, pf := range pass.AllPackageFacts() {
+		if fact, ok := pf.Fact.(*routesFact); ok {
+			for _, r := range fact.Routes {
+				add(r)
+			}
+		}
+	}
+	if len(implemented) == 0 {
+		return
+	}
+
+	var missing []string
+	for path, item := range doc.Paths {
+		if item == nil {
+			continue
+		}
+		key := templateKey(path)
+		for method := range item.operations() {
+			if implemented[method+" "+key] || implemented[" "+key] {
+				continue
+			}
+			// As with http.ServeMux, a GET route also serves HEAD.
+			if method == "HEAD" && implemented["GET "+key] {
+				continue
+			}
+			missing = append(missing, method+" "+path)
+		}
+	}
+	sort.Strings(missing)
+	pos := analysisutil.MainReportPos(pass)
+	for _, m := range missing {
+		pass.Reportf(pos, "LINT-047: OpenAPI operation %q has no handler", m)
+	}
+}
@@ -0,0 +1,25 @@
+package lint047_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint047"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	if err := lint047.Analyzer.Flags.Set("spec", filepath.Join(testdata, "src", "lint047", "openapi.yaml")); err != nil {
+		t.Fatalf("failed to set lint047 spec flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint047.Analyzer.Flags.Set("spec", "")
+	})
+
+	analysistest.Run(t, testdata, lint047.Analyzer, "lint047/userhandler", "lint047/cmd")
+}
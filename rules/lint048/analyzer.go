@@ -0,0 +1,269 @@
+package lint048
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint048",
+	Doc:       "LINT-048: routes must not be registered twice or conflict with each other",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*routesFact)(nil)},
+}
+
+// routesFact records the routes registered in a package, except those
+// already reported as clashing within the package.
+type routesFact struct {
+	Routes []registration
+}
+
+// registration is a route pattern ("GET /users/{id}") and where it is
+// registered ("userhandler/routes.go:12", relative to the module root).
+type registration struct {
+	Pattern string
+	Pos     string
+}
+
+func (*routesFact) AFact() {}
+
+func (f *routesFact) String() string {
+	return "routes"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	var local []registration
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		if !strings.HasPrefix(route.Path, "/") {
+			continue
+		}
+		reg := registration{
+			Pattern: strings.TrimSpace(route.Method + " " + route.Path),
+			Pos:     analysisutil.ModulePosition(pass, route.Pattern.Pos()),
+		}
+		if prev, msg := firstConflict(local, reg); msg != "" {
+			pass.Reportf(route.Pattern.Pos(), "LINT-048: route %q %s %q registered at %s", reg.Pattern, msg, prev.Pattern, prev.Pos)
+			continue
+		}
+		local = append(local, reg)
+	}
+	if len(local) > 0 {
+		pass.ExportPackageFact(&routesFact{Routes: local})
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportModule(pass)
+	}
+
+	return nil, nil
+}
+
+// firstConflict returns the first registration of regs reg clashes with and
+// how.
+func firstConflict(regs []registration, reg registration) (registration, string) {
+	for _, prev := range regs {
+		if msg := conflict(prev.Pattern, reg.Pattern); msg != "" {
+			return prev, msg
+		}
+	}
+	return registration{}, ""
+}
+
+// reportModule reports, on func main, conflicts between routes registered in
+// different packages reachable from the main package. Conflicts within a
+// package are reported where they are registered.
+func reportModule(pass *analysis.Pass) {
+	type pkgRoutes struct {
+		path   string
+		routes []registration
+	}
+	var pkgs []pkgRoutes
+	for _, pf := range pass.AllPackageFacts() {
+		if fact, ok := pf.Fact.(*routesFact); ok {
+			pkgs = append(pkgs, pkgRoutes{path: pf.Package.Path(), routes: fact.Routes})
+		}
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].path < pkgs[j].path })
+
+	pos := analysisutil.MainReportPos(pass)
+	for i, p1 := range pkgs {
+		for _, p2 := range pkgs[i+1:] {
+			for _, r1 := range p1.routes {
+				for _, r2 := range p2.routes {
+					if msg := conflict(r1.Pattern, r2.Pattern); msg != "" {
+						pass.Reportf(pos, "LINT-048: route %q registered at %s %s %q registered at %s", r2.Pattern, r2.Pos, msg, r1.Pattern, r1.Pos)
+					}
+				}
+			}
+		}
+	}
+}
+
+// conflict describes how the patterns p1 and p2 clash for http.ServeMux, or
+// returns "" when they can be registered together.
+func conflict(p1, p2 string) string {
+	if p1 == p2 {
+		return "duplicates"
+	}
+	m1, path1 := analysisutil.SplitPattern(p1)
+	m2, path2 := analysisutil.SplitPattern(p2)
+	mrel := compareMethods(m1, m2)
+	if mrel == disjoint {
+		return ""
+	}
+	switch combine(mrel, comparePaths(parseSegments(path1), parseSegments(path2))) {
+	case equivalent:
+		return "is equivalent to"
+	case overlaps:
+		return "conflicts with"
+	}
+	return ""
+}
+
+// relationship is the relation between the sets of requests two patterns
+// match, as defined by http.ServeMux.
+type relationship int
+
+const (
+	equivalent relationship = iota
+	moreGeneral
+	moreSpecific
+	overlaps
+	disjoint
+)
+
+func inverse(r relationship) relationship {
+	switch r {
+	case moreGeneral:
+		return moreSpecific
+	case moreSpecific:
+		return moreGeneral
+	}
+	return r
+}
+
+func combine(r1, r2 relationship) relationship {
+	switch r1 {
+	case equivalent:
+		return r2
+	case disjoint:
+		return disjoint
+	case overlaps:
+		if r2 == disjoint {
+			return disjoint
+		}
+		return overlaps
+	}
+	switch r2 {
+	case equivalent:
+		return r1
+	case inverse(r1):
+		return overlaps
+	}
+	return r2
+}
+
+func compareMethods(m1, m2 string) relationship {
+	switch {
+	case m1 == m2:
+		return equivalent
+	case m1 == "":
+		return moreGeneral
+	case m2 == "":
+		return moreSpecific
+	case m1 == "GET" && m2 == "HEAD":
+		return moreGeneral
+	case m1 == "HEAD" && m2 == "GET":
+		return moreSpecific
+	}
+	return disjoint
+}
+
+// segment is a path segment of a pattern: a literal, a {name} wildcard, or a
+// {name...} (or trailing slash) multi wildcard. {$} is the literal "/".
+type segment struct {
+	s     string
+	wild  bool
+	multi bool
+}
+
+func parseSegments(path string) []segment {
+	var segs []segment
+	rest := strings.TrimPrefix(path, "/")
+	for {
+		if rest == "" {
+			return append(segs, segment{multi: true})
+		}
+		seg, after, more := strings.Cut(rest, "/")
+		switch {
+		case seg == "{$}":
+			segs = append(segs, segment{s: "/"})
+		case strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "...}"):
+			segs = append(segs, segment{multi: true})
+		case strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}"):
+			segs = append(segs, segment{wild: true})
+		default:
+			segs = append(segs, segment{s: seg})
+		}
+		if !more {
+			return segs
+		}
+		rest = after
+	}
+}
+
+func comparePaths(segs1, segs2 []segment) relationship {
+	multi1 := segs1[len(segs1)-1].multi
+	multi2 := segs2[len(segs2)-1].multi
+	if len(segs1) != len(segs2) && !multi1 && !multi2 {
+		return disjoint
+	}
+
+	rel := equivalent
+	for len(segs1) > 0 && len(segs2) > 0 {
+		rel = combine(rel, compareSegments(segs1[0], segs2[0]))
+		if rel == disjoint {
+			return rel
+		}
+		segs1, segs2 = segs1[1:], segs2[1:]
+	}
+	switch {
+	case len(segs1) == 0 && len(segs2) == 0:
+		return rel
+	case len(segs1) < len(segs2) && multi1:
+		return combine(rel, moreGeneral)
+	case len(segs2) < len(segs1) && multi2:
+		return combine(rel, moreSpecific)
+	}
+	return disjoint
+}
+
+func compareSegments(s1, s2 segment) relationship {
+	switch {
+	case s1.multi && s2.multi:
+		return equivalent
+	case s1.multi:
+		return moreGeneral
+	case s2.multi:
+		return moreSpecific
+	case s1.wild && s2.wild:
+		return equivalent
+	case s1.wild:
+		if s2.s == "/" {
+			return disjoint
+		}
+		return moreGeneral
+	case s2.wild:
+		if s1.s == "/" {
+			return disjoint
+		}
+		return moreSpecific
+	case s1.s == s2.s:
+		return equivalent
+	}
+	return disjoint
+}
@@ -0,0 +1,18 @@
+package lint048_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint048"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	analysistest.Run(t, testdata, lint048.Analyzer, "lint048/userhandler", "lint048/adminhandler", "lint048/cmd")
+}
@@ -0,0 +1,132 @@
+package lint049
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	prefixFlag     string
+	verbsFlag      string
+	exceptionsFlag string
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint049",
+	Doc:  "LINT-049: route paths must follow the REST URL style",
+	Run:  run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&prefixFlag,
+		"prefix",
+		"",
+		`path prefix every route must start with, e.g. "/api/v1" (not checked when empty)`,
+	)
+	Analyzer.Flags.StringVar(
+		&verbsFlag,
+		"verbs",
+		"get,list,create,update,delete,remove,add,set,fetch",
+		"comma-separated verbs that must not start a static path segment",
+	)
+	Analyzer.Flags.StringVar(
+		&exceptionsFlag,
+		"exceptions",
+		"data,media,metadata,people,children,staff",
+		"comma-separated collection segments exempt from the plural check",
+	)
+}
+
+// kebabSegment matches kebab-case segments, optionally with a file extension
+// (openapi.json).
+var kebabSegment = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*(\.[a-z0-9]+)*$`)
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	verbs := analysisutil.SplitSet(verbsFlag)
+	exceptions := analysisutil.SplitSet(exceptionsFlag)
+	prefix := strings.TrimSuffix(prefixFlag, "/")
+
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		path := route.Path
+		if !strings.HasPrefix(path, "/") {
+			continue
+		}
+		name := strings.TrimSpace(route.Method + " " + path)
+		pos := route.Pattern.Pos()
+
+		if prefix != "" && path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			pass.Reportf(pos, "LINT-049: route %q must start with %q", name, prefix)
+		}
+		if path != "/" && strings.HasSuffix(path, "/") {
+			pass.Reportf(pos, "LINT-049: route %q must not end with a slash", name)
+		}
+
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		for i, seg := range segments {
+			if seg == "" || seg == "{$}" || isParam(seg) {
+				continue
+			}
+			if !kebabSegment.MatchString(seg) {
+				pass.Reportf(pos, "LINT-049: path segment %q of route %q must be kebab-case", seg, name)
+				continue
+			}
+			if isVerbSegment(seg, verbs) {
+				pass.Reportf(pos, "LINT-049: path segment %q of route %q is a verb, use the HTTP method instead", seg, name)
+				continue
+			}
+			if i+1 < len(segments) && isParam(segments[i+1]) && !isPluralSegment(seg, exceptions) {
+				pass.Reportf(pos, "LINT-049: collection segment %q of route %q must be plural (%q)", seg, name, pluralizeSegment(seg))
+			}
+		}
+
+		if route.Method == "POST" && isParam(segments[len(segments)-1]) {
+			pass.Reportf(pos, "LINT-049: POST route %q must target a collection, not an item path ending in a parameter", name)
+		}
+	}
+
+	return nil, nil
+}
+
+// isParam reports whether seg is a {name} or {name...} wildcard. {$} is not.
+func isParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") && seg != "{$}"
+}
+
+// particles turn a leading verb into a compound noun: add-ons, sign-ups,
+// check-ins, roll-outs.
+var particles = map[string]bool{
+	"on": true, "ons": true, "up": true, "ups": true, "in": true, "ins": true,
+	"out": true, "outs": true, "off": true, "offs": true, "back": true, "backs": true,
+}
+
+// isVerbSegment reports whether the first word of the kebab-case segment is a
+// whole verb (create-user, but not created or creator) that is not part of a
+// compound noun (add-ons).
+func isVerbSegment(seg string, verbs map[string]bool) bool {
+	words := strings.Split(seg, "-")
+	if !verbs[words[0]] {
+		return false
+	}
+	return len(words) == 1 || !particles[words[1]]
+}
+
+// isPluralSegment reports whether the last word of the kebab-case segment is
+// plural.
+func isPluralSegment(seg string, exceptions map[string]bool) bool {
+	if exceptions[seg] {
+		return true
+	}
+	words := strings.Split(seg, "-")
+	last := words[len(words)-1]
+	return exceptions[last] || analysisutil.IsPlural(last)
+}
+
+func pluralizeSegment(seg string) string {
+	i := strings.LastIndex(seg, "-")
+	return seg[:i+1] + analysisutil.Pluralize(seg[i+1:])
+}
@@ -0,0 +1,25 @@
+package lint049_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint049"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	if err := lint049.Analyzer.Flags.Set("prefix", "/api/v1/"); err != nil {
+		t.Fatalf("failed to set lint049 prefix flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint049.Analyzer.Flags.Set("prefix", "")
+	})
+
+	analysistest.Run(t, testdata, lint049.Analyzer, "lint049")
+}
@@ -0,0 +1,143 @@
+package lint050
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var verbsFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint050",
+	Doc:  "LINT-050: route HTTP method and path shape must match the handler method verb",
+	Run:  run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&verbsFlag,
+		"verbs",
+		"Get=GET:item,List=GET:collection,Create=POST,Update=PUT|PATCH,Delete=DELETE",
+		`comma-separated handler method prefixes and their routes, as "Prefix=METHOD[|METHOD...][:item|collection]"`,
+	)
+}
+
+// verb is the route expected for handler methods starting with prefix.
+type verb struct {
+	prefix  string
+	methods []string
+	// shape is "item", "collection" or "" (any path).
+	shape string
+}
+
+func parseVerbs(flag string) ([]verb, error) {
+	var out []verb
+	for _, entry := range strings.Split(flag, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, spec, ok := strings.Cut(entry, "=")
+		if !ok || prefix == "" || spec == "" {
+			return nil, fmt.Errorf("LINT-050: invalid verb mapping %q, want Prefix=METHOD[|METHOD...][:item|collection]", entry)
+		}
+		methods, shape, _ := strings.Cut(spec, ":")
+		if shape != "" && shape != "item" && shape != "collection" {
+			return nil, fmt.Errorf("LINT-050: invalid path shape %q in verb mapping %q, want item or collection", shape, entry)
+		}
+		v := verb{prefix: prefix, shape: shape}
+		for _, m := range strings.Split(methods, "|") {
+			v.methods = append(v.methods, strings.ToUpper(strings.TrimSpace(m)))
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	verbs, err := parseVerbs(verbsFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		handler, ok := handlerName(route.HandlerFunc)
+		if !ok {
+			continue
+		}
+		v, ok := matchVerb(verbs, route.HandlerFunc.Name())
+		if !ok {
+			continue
+		}
+		checkRoute(pass, route, handler, v)
+	}
+
+	return nil, nil
+}
+
+// handlerName returns "AssetHandler.ListAssets" when fn is a method of a
+// *Handler type declared in a module-scoped handler package.
+func handlerName(fn *types.Func) (string, bool) {
+	if fn == nil || fn.Pkg() == nil {
+		return "", false
+	}
+	pkg := fn.Pkg().Name()
+	if !analysisutil.IsHandlerPackage(pkg) || pkg == "handler" {
+		return "", false
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return "", false
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || !strings.HasSuffix(named.Obj().Name(), "Handler") {
+		return "", false
+	}
+	return named.Obj().Name() + "." + fn.Name(), true
+}
+
+// matchVerb returns the verb with the longest prefix of name, where the prefix
+// ends at a word boundary (Get matches GetAsset, not Getaway).
+func matchVerb(verbs []verb, name string) (verb, bool) {
+	var best verb
+	found := false
+	for _, v := range verbs {
+		if !analysisutil.HasVerbPrefix(name, []string{v.prefix}) {
+			continue
+		}
+		if !found || len(v.prefix) > len(best.prefix) {
+			best, found = v, true
+		}
+	}
+	return best, found
+}
+
+func checkRoute(pass *analysis.Pass, route analysisutil.Route, handler string, v verb) {
+	if !slices.Contains(v.methods, route.Method) {
+		got := route.Method
+		if got == "" {
+			got = "no method"
+		}
+		pass.Reportf(route.Pattern.Pos(), "LINT-050: handler %s must be registered with %s, got %s", handler, strings.Join(v.methods, " or "), got)
+	}
+
+	segments := strings.Split(strings.TrimSuffix(route.Path, "/"), "/")
+	last := segments[len(segments)-1]
+	item := strings.HasPrefix(last, "{") && strings.HasSuffix(last, "}") && last != "{$}"
+	switch {
+	case v.shape == "item" && !item:
+		pass.Reportf(route.Pattern.Pos(), "LINT-050: handler %s must be registered on an item path ending in a parameter, got %q", handler, route.Path)
+	case v.shape == "collection" && item:
+		pass.Reportf(route.Pattern.Pos(), "LINT-050: handler %s must be registered on a collection path, got %q", handler, route.Path)
+	}
+}
@@ -0,0 +1,250 @@
+package lint051
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint051",
+	Doc:       "LINT-051: huma registrations must use a *Handler route method and a unique, derived operation ID",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*operationsFact)(nil)},
+}
+
+// operationsFact records the huma operation IDs registered in a package.
+type operationsFact struct {
+	Operations []operation
+}
+
+// operation is an operation ID and where it is registered
+// ("assethandler/handler.go:12", relative to the module root).
+type operation struct {
+	ID  string
+	Pos string
+}
+
+func (*operationsFact) AFact() {}
+
+func (f *operationsFact) String() string {
+	return "operations"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	handlerPkg := analysisutil.IsHandlerPackage(pass.Pkg.Name()) && pass.Pkg.Name() != "handler"
+
+	var local []operation
+	seen := make(map[string]string)
+	for _, route := range analysisutil.FindRoutes(pass, pass.Files) {
+		if !route.Huma {
+			continue
+		}
+		var method *types.Func
+		if handlerPkg {
+			method = checkHandler(pass, route)
+		}
+
+		if route.Operation == nil {
+			continue
+		}
+		id, idExpr := operationID(pass, route.Operation)
+		if method != nil {
+			checkOperationID(pass, route, id, idExpr, method)
+		}
+		if id == "" {
+			continue
+		}
+		pos := analysisutil.ModulePosition(pass, idExpr.Pos())
+		if other, dup := seen[id]; dup {
+			pass.Reportf(idExpr.Pos(), "LINT-051: huma operation ID %q is already used at %s", id, other)
+			continue
+		}
+		seen[id] = pos
+		local = append(local, operation{ID: id, Pos: pos})
+	}
+	if len(local) > 0 {
+		pass.ExportPackageFact(&operationsFact{Operations: local})
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportModule(pass)
+	}
+
+	return nil, nil
+}
+
+// checkHandler checks that the registered function is a method of a
+// *{Name}Handler type of the package with the route signature, and returns
+// the method, or nil when it is not such a method.
+func checkHandler(pass *analysis.Pass, route analysisutil.Route) *types.Func {
+	pos := route.Call.Pos()
+	if len(route.Call.Args) > 2 {
+		pos = route.Call.Args[2].Pos()
+	}
+
+	fn := route.HandlerFunc
+	if fn == nil || fn.Pkg() != pass.Pkg || !isHandlerMethod(fn) {
+		pass.Reportf(pos, "LINT-051: huma handler must be a method of a *{Name}Handler type of package %s", pass.Pkg.Name())
+		return nil
+	}
+
+	name := fn.Name()
+	if !hasRouteSignature(fn.Type().(*types.Signature), name) {
+		pass.Reportf(pos, "LINT-051: huma handler %s must have signature func(context.Context, *%sInput) (*%sOutput, error)", name, name, name)
+	}
+	return fn
+}
+
+func isHandlerMethod(fn *types.Func) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	ptr, ok := recv.Type().(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	return ok && strings.HasSuffix(named.Obj().Name(), "Handler")
+}
+
+// hasRouteSignature reports whether sig is
+// func(context.Context, *{name}Input) (*{name}Output, error).
+func hasRouteSignature(sig *types.Signature, name string) bool {
+	if sig.Params().Len() != 2 || sig.Results().Len() != 2 {
+		return false
+	}
+	return analysisutil.IsContextType(sig.Params().At(0).Type()) &&
+		isPointerTo(sig.Params().At(1).Type(), name+"Input") &&
+		isPointerTo(sig.Results().At(0).Type(), name+"Output") &&
+		analysisutil.IsErrorType(sig.Results().At(1).Type())
+}
+
+func isPointerTo(t types.Type, name string) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	return ok && named.Obj().Name() == name
+}
+
+// operationID returns the constant OperationID of lit and its expression, or
+// the literal itself when the field is missing or not constant.
+func operationID(pass *analysis.Pass, lit *ast.CompositeLit) (string, ast.Expr) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "OperationID" {
+			id, _ := analysisutil.ConstantString(pass, kv.Value)
+			return id, kv.Value
+		}
+	}
+	return "", lit
+}
+
+func checkOperationID(pass *analysis.Pass, route analysisutil.Route, id string, idExpr ast.Expr, fn *types.Func) {
+	method := fn.Name()
+	want := expectedOperationID(fn)
+	if id == want {
+		return
+	}
+	if idExpr == route.Operation {
+		pass.Reportf(route.Operation.Pos(), "LINT-051: huma operation for %s must set OperationID %q", method, want)
+		return
+	}
+	if id == "" {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     idExpr.Pos(),
+		Message: fmt.Sprintf("LINT-051: huma operation ID %q of %s must be %q", id, method, want),
+	}
+	if lit, ok := idExpr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: fmt.Sprintf("Use %q", want),
+			TextEdits: []analysis.TextEdit{
+				{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(want))},
+			},
+		}}
+	}
+	pass.Report(diag)
+}
+
+// expectedOperationID returns the operation ID of a handler method: the
+// method name in kebab-case, with the handler resource inserted after the
+// leading verb when the name does not already carry it, so that IDs stay
+// unique across handlers: AssetHandler.ListAssets -> list-assets,
+// UserHandler.ListAssets -> list-user-assets, UserHandler.Get -> get-user.
+func expectedOperationID(fn *types.Func) string {
+	words := analysisutil.SplitWords(fn.Name())
+	recv := fn.Type().(*types.Signature).Recv().Type().(*types.Pointer).Elem().(*types.Named)
+	resource := analysisutil.SplitWords(strings.TrimSuffix(recv.Obj().Name(), "Handler"))
+	if len(resource) == 0 || containsWords(words, resource) {
+		return strings.Join(words, "-")
+	}
+	out := append([]string{words[0]}, resource...)
+	return strings.Join(append(out, words[1:]...), "-")
+}
+
+// containsWords reports whether words contains the sequence sub, its last
+// word possibly in plural form (asset, assets).
+func containsWords(words, sub []string) bool {
+	plural := analysisutil.Pluralize(sub[len(sub)-1])
+	for i := 0; i+len(sub) <= len(words); i++ {
+		match := true
+		for j, w := range sub {
+			got := words[i+j]
+			if got != w && (j < len(sub)-1 || got != plural) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// reportModule reports, on func main, operation IDs registered in more than
+// one package reachable from the main package.
+func reportModule(pass *analysis.Pass) {
+	byID := make(map[string][]string)
+	for _, pf := range pass.AllPackageFacts() {
+		fact, ok := pf.Fact.(*operationsFact)
+		if !ok {
+			continue
+		}
+		for _, op := range fact.Operations {
+			byID[op.ID] = append(byID[op.ID], op.Pos)
+		}
+	}
+
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	pos := analysisutil.MainReportPos(pass)
+	for _, id := range ids {
+		positions := byID[id]
+		if len(positions) < 2 {
+			continue
+		}
+		sort.Strings(positions)
+		pass.Reportf(pos, "LINT-051: huma operation ID %q is used at both %s and %s", id, positions[0], positions[1])
+	}
+}
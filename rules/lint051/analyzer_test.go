@@ -0,0 +1,18 @@
+package lint051_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint051"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	analysistest.RunWithSuggestedFixes(t, testdata, lint051.Analyzer, "lint051/assethandler", "lint051/userhandler", "lint051/cmd")
+}
@@ -0,0 +1,258 @@
+package lint052
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var casingFlag string
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint052",
+	Doc:  "LINT-052: handler body struct fields must have json tags with consistent casing and unique names",
+	Run:  run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&casingFlag,
+		"casing",
+		"camel",
+		"json name casing: camel (lowerCamelCase) or snake (snake_case)",
+	)
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if casingFlag != "camel" && casingFlag != "snake" {
+		return nil, fmt.Errorf("LINT-052: invalid casing %q, want camel or snake", casingFlag)
+	}
+
+	pkgName := pass.Pkg.Name()
+	handlertypes := pkgName == "handlertypes"
+	if !handlertypes && !analysisutil.IsHandlerPackage(pkgName) {
+		return nil, nil
+	}
+
+	var bodyOnly map[string]bool
+	if !handlertypes {
+		bodyOnly = analysisutil.AnalyzeBodyTypeUsage(pass).BodyOnlyStructs
+	}
+
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				name := ts.Name.Name
+				wrapper := isWrapper(name)
+				if !handlertypes && !wrapper && !bodyOnly[name] {
+					continue
+				}
+				checkStruct(pass, ts, st, wrapper && !isBodyStruct(name))
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// isWrapper reports whether name is a route *Input/*Output type, including
+// *BodyInput/*BodyOutput.
+func isWrapper(name string) bool {
+	return strings.HasSuffix(name, "Input") || strings.HasSuffix(name, "Output")
+}
+
+func isBodyStruct(name string) bool {
+	return strings.HasSuffix(name, "BodyInput") || strings.HasSuffix(name, "BodyOutput")
+}
+
+// checkStruct checks the json tags of the fields of ts. In route wrappers,
+// path/query/header parameters and the Body field are not json fields.
+func checkStruct(pass *analysis.Pass, ts *ast.TypeSpec, st *ast.StructType, routeWrapper bool) {
+	for _, field := range st.Fields.List {
+		tag := fieldTag(field)
+		if len(field.Names) == 0 || (routeWrapper && isParam(tag)) {
+			continue
+		}
+		for _, name := range field.Names {
+			if !name.IsExported() || (routeWrapper && name.Name == "Body") {
+				continue
+			}
+			checkField(pass, ts.Name.Name, field, name.Name, tag)
+		}
+	}
+
+	if obj, ok := pass.TypesInfo.Defs[ts.Name].(*types.TypeName); ok {
+		checkDuplicates(pass, ts, obj.Type().Underlying().(*types.Struct), routeWrapper)
+	}
+}
+
+func checkField(pass *analysis.Pass, typeName string, field *ast.Field, name string, tag reflect.StructTag) {
+	value, ok := tag.Lookup("json")
+	jsonName, _, _ := strings.Cut(value, ",")
+	if jsonName == "-" {
+		return
+	}
+	if !ok || jsonName == "" {
+		pass.Report(analysis.Diagnostic{
+			Pos:            field.Pos(),
+			Message:        fmt.Sprintf("LINT-052: exported field %s.%s must have a json tag", typeName, name),
+			SuggestedFixes: tagFix(field, convert(name), len(field.Names) == 1),
+		})
+		return
+	}
+	want := convert(jsonName)
+	if jsonName == want {
+		return
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:            field.Tag.Pos(),
+		Message:        fmt.Sprintf("LINT-052: json name %q of %s.%s must be %s", jsonName, typeName, name, casingName()),
+		SuggestedFixes: tagFix(field, want, len(field.Names) == 1),
+	})
+}
+
+// tagFix sets the json name of field to name. No fix is offered for fields
+// declared together (A, B string), which share a tag.
+func tagFix(field *ast.Field, name string, single bool) []analysis.SuggestedFix {
+	if !single {
+		return nil
+	}
+	edit := analysis.TextEdit{Pos: field.Type.End(), End: field.Type.End(), NewText: []byte(" `json:" + strconv.Quote(name) + "`")}
+	if field.Tag != nil {
+		updated, ok := analysisutil.SetTagName(field.Tag.Value, "json", name)
+		if !ok {
+			return nil
+		}
+		edit = analysis.TextEdit{Pos: field.Tag.Pos(), End: field.Tag.End(), NewText: []byte(updated)}
+	}
+	return []analysis.SuggestedFix{{
+		Message:   fmt.Sprintf("Set json name %q", name),
+		TextEdits: []analysis.TextEdit{edit},
+	}}
+}
+
+// jsonField is a field contributing a json name to a struct, possibly
+// promoted through embedded structs.
+type jsonField struct {
+	name string
+	// path is the Go selector of the field (Address.City).
+	path string
+	// top is the field of the checked struct that declares or embeds it.
+	top *types.Var
+}
+
+// checkDuplicates reports json names used by more than one field of st,
+// including fields promoted from embedded structs without a json tag.
+func checkDuplicates(pass *analysis.Pass, ts *ast.TypeSpec, st *types.Struct, routeWrapper bool) {
+	seen := make(map[string]jsonField)
+	for _, f := range collectJSONFields(st, routeWrapper, "", nil, make(map[*types.Struct]bool)) {
+		prev, dup := seen[f.name]
+		if !dup {
+			seen[f.name] = f
+			continue
+		}
+		pos := f.top.Pos()
+		if prev.top == f.top {
+			pos = ts.Name.Pos()
+		}
+		pass.Reportf(pos, "LINT-052: json name %q of %s.%s is already used by %s.%s", f.name, ts.Name.Name, f.path, ts.Name.Name, prev.path)
+	}
+}
+
+func collectJSONFields(st *types.Struct, routeWrapper bool, prefix string, top *types.Var, visiting map[*types.Struct]bool) []jsonField {
+	if visiting[st] {
+		return nil
+	}
+	visiting[st] = true
+	defer delete(visiting, st)
+
+	var out []jsonField
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		fieldTop := top
+		if fieldTop == nil {
+			fieldTop = field
+		}
+		if routeWrapper && (isParam(tag) || field.Name() == "Body") {
+			continue
+		}
+		value, tagged := tag.Lookup("json")
+		name, _, _ := strings.Cut(value, ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Embedded() && name == "" {
+			t := field.Type()
+			if ptr, ok := t.(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+			if embedded, ok := t.Underlying().(*types.Struct); ok {
+				out = append(out, collectJSONFields(embedded, false, prefix+field.Name()+".", fieldTop, visiting)...)
+				continue
+			}
+		}
+		if !field.Exported() {
+			continue
+		}
+		if !tagged || name == "" {
+			name = field.Name()
+		}
+		out = append(out, jsonField{name: name, path: prefix + field.Name(), top: fieldTop})
+	}
+	return out
+}
+
+func fieldTag(field *ast.Field) reflect.StructTag {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return reflect.StructTag(tag)
+}
+
+func isParam(tag reflect.StructTag) bool {
+	for _, key := range []string{"path", "query", "header"} {
+		if _, ok := tag.Lookup(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func casingName() string {
+	if casingFlag == "snake" {
+		return "snake_case"
+	}
+	return "lowerCamelCase"
+}
+
+// convert returns name in the configured casing, keeping acronyms together:
+// UserID -> userId or user_id.
+func convert(name string) string {
+	if casingFlag == "snake" {
+		return analysisutil.ToSnake(name)
+	}
+	return analysisutil.ToLowerCamel(name)
+}
@@ -0,0 +1,31 @@
+package lint052_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint052"
+)
+
+func testdataDir() string {
+	_, thisFile, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+}
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, testdataDir(), lint052.Analyzer, "lint052")
+}
+
+func TestAnalyzerSnakeCase(t *testing.T) {
+	if err := lint052.Analyzer.Flags.Set("casing", "snake"); err != nil {
+		t.Fatalf("failed to set lint052 casing flag: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = lint052.Analyzer.Flags.Set("casing", "camel")
+	})
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(), lint052.Analyzer, "lint052types")
+}
@@ -0,0 +1,178 @@
+package lint053
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"net/textproto"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var (
+	queryCasingFlag  string
+	headerCasingFlag string
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: "lint053",
+	Doc:  "LINT-053: query and header tags must follow their casing, be unique and be on exported fields",
+	Run:  run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(
+		&queryCasingFlag,
+		"query-casing",
+		"camel",
+		"query tag casing: camel (lowerCamelCase), snake (snake_case) or kebab (kebab-case)",
+	)
+	Analyzer.Flags.StringVar(
+		&headerCasingFlag,
+		"header-casing",
+		"canonical",
+		"header tag casing: canonical (Canonical-Header-Case) or lower (lower-case)",
+	)
+}
+
+// paramKeys are the parameter tags of route Input structs.
+var paramKeys = []string{"path", "query", "header"}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	switch queryCasingFlag {
+	case "camel", "snake", "kebab":
+	default:
+		return nil, fmt.Errorf("LINT-053: invalid query casing %q, want camel, snake or kebab", queryCasingFlag)
+	}
+	if headerCasingFlag != "canonical" && headerCasingFlag != "lower" {
+		return nil, fmt.Errorf("LINT-053: invalid header casing %q, want canonical or lower", headerCasingFlag)
+	}
+	if !analysisutil.IsHandlerPackage(pass.Pkg.Name()) {
+		return nil, nil
+	}
+
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range st.Fields.List {
+					checkField(pass, ts.Name.Name, field)
+				}
+				if strings.HasSuffix(ts.Name.Name, "Input") {
+					checkDuplicates(pass, ts)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func checkField(pass *analysis.Pass, typeName string, field *ast.Field) {
+	for _, key := range paramKeys {
+		name, ok := analysisutil.TagName(field, key)
+		if !ok {
+			continue
+		}
+		for _, n := range field.Names {
+			if !n.IsExported() {
+				pass.Reportf(field.Tag.Pos(), "LINT-053: %s tag %q of unexported field %s.%s is ignored at runtime", key, name, typeName, n.Name)
+			}
+		}
+
+		var want, casing string
+		switch key {
+		case "query":
+			want, casing = queryName(name)
+		case "header":
+			want, casing = headerName(name)
+		default:
+			continue
+		}
+		if name == want {
+			continue
+		}
+		diag := analysis.Diagnostic{
+			Pos:     field.Tag.Pos(),
+			Message: fmt.Sprintf("LINT-053: %s tag %q must be %s", key, name, casing),
+		}
+		if updated, ok := analysisutil.SetTagName(field.Tag.Value, key, want); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: fmt.Sprintf("Rename %s tag to %q", key, want),
+				TextEdits: []analysis.TextEdit{
+					{Pos: field.Tag.Pos(), End: field.Tag.End(), NewText: []byte(updated)},
+				},
+			}}
+		}
+		pass.Report(diag)
+	}
+}
+
+// checkDuplicates reports parameter names declared more than once across the
+// path, query and header tags of an Input struct, including embedded structs.
+// Names are compared case-insensitively, as header names are.
+func checkDuplicates(pass *analysis.Pass, ts *ast.TypeSpec) {
+	obj, ok := pass.TypesInfo.Defs[ts.Name].(*types.TypeName)
+	if !ok {
+		return
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return
+	}
+
+	type param struct {
+		key   string
+		field analysisutil.TaggedField
+	}
+	seen := make(map[string]param)
+	for _, key := range paramKeys {
+		for _, f := range analysisutil.TaggedFields(named, key) {
+			lower := strings.ToLower(f.Name)
+			prev, dup := seen[lower]
+			if !dup {
+				seen[lower] = param{key: key, field: f}
+				continue
+			}
+			pos := f.Field.Pos()
+			if !analysisutil.ContainsPos(pass, pos) {
+				pos = ts.Name.Pos()
+			}
+			pass.Reportf(pos, "LINT-053: %s:%q of %s.%s duplicates %s:%q of %s.%s",
+				key, f.Name, ts.Name.Name, f.Field.Name(), prev.key, prev.field.Name, ts.Name.Name, prev.field.Field.Name())
+		}
+	}
+}
+
+// queryName returns name in the configured query casing and the casing name.
+func queryName(name string) (string, string) {
+	switch queryCasingFlag {
+	case "snake":
+		return analysisutil.ToSnake(name), "snake_case"
+	case "kebab":
+		return strings.Join(analysisutil.SplitWords(name), "-"), "kebab-case"
+	}
+	return analysisutil.ToLowerCamel(name), "lowerCamelCase"
+}
+
+// headerName returns name in the configured header casing and the casing
+// name.
+func headerName(name string) (string, string) {
+	name = strings.ReplaceAll(name, "_", "-")
+	if headerCasingFlag == "lower" {
+		return strings.ToLower(name), "lower-case"
+	}
+	return textproto.CanonicalMIMEHeaderKey(name), "Canonical-Header-Case"
+}
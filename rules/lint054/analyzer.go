@@ -0,0 +1,257 @@
+package lint054
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/alexisvisco/relint/analysisutil"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "lint054",
+	Doc:       "LINT-054: shared payload structs must live in handlertypes under descriptive names",
+	Run:       run,
+	FactTypes: []analysis.Fact{(*payloadsFact)(nil)},
+}
+
+// payloadsFact records the handlertypes types declared and used in a package
+// and the body structs of module-scoped handler packages.
+type payloadsFact struct {
+	// Declared are the structs of a handlertypes package.
+	Declared []sharedType
+	// Uses are the handlertypes types referenced by the package, as
+	// "pkgpath.Name".
+	Uses   []string
+	Bodies []body
+}
+
+// sharedType is a handlertypes struct and where it is declared
+// ("handlertypes/tenant.go:5", relative to the module root).
+type sharedType struct {
+	Name string
+	Pos  string
+}
+
+// body is a body struct of a handler package: its package path, its name
+// ("userhandler.ProfileBodyOutput"), where it is declared and its struct
+// type, fully qualified.
+type body struct {
+	Pkg   string
+	Name  string
+	Pos   string
+	Shape string
+}
+
+func (*payloadsFact) AFact() {}
+
+func (f *payloadsFact) String() string {
+	return "payloads"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	var fact payloadsFact
+	switch name := pass.Pkg.Name(); {
+	case name == "handlertypes":
+		fact.Declared = checkSharedTypes(pass)
+	case analysisutil.IsHandlerPackage(name) && name != "handler":
+		fact.Bodies = bodyStructs(pass)
+	}
+	fact.Uses = sharedTypeUses(pass)
+	if len(fact.Declared) > 0 || len(fact.Uses) > 0 || len(fact.Bodies) > 0 {
+		pass.ExportPackageFact(&fact)
+	}
+
+	if pass.Pkg.Name() == "main" {
+		reportModule(pass)
+	}
+
+	return nil, nil
+}
+
+// checkSharedTypes reports handlertypes structs named like route bodies and
+// returns the structs of the package.
+func checkSharedTypes(pass *analysis.Pass) []sharedType {
+	var out []sharedType
+	for _, ts := range structSpecs(pass) {
+		name := ts.Name.Name
+		out = append(out, sharedType{Name: name, Pos: analysisutil.ModulePosition(pass, ts.Name.Pos())})
+
+		for _, suffix := range []string{"BodyInput", "BodyOutput"} {
+			base, ok := strings.CutSuffix(name, suffix)
+			if !ok {
+				continue
+			}
+			if base == "" {
+				pass.Reportf(ts.Name.Pos(), "LINT-054: handlertypes struct %s must use a descriptive name, not *%s", name, suffix)
+			} else {
+				pass.Reportf(ts.Name.Pos(), "LINT-054: handlertypes struct %s must use a descriptive name such as %s, not *%s", name, base, suffix)
+			}
+		}
+	}
+	return out
+}
+
+// sharedTypeUses returns the handlertypes types referenced by the package.
+func sharedTypeUses(pass *analysis.Pass) []string {
+	seen := make(map[string]bool)
+	for _, obj := range pass.TypesInfo.Uses {
+		tn, ok := obj.(*types.TypeName)
+		if !ok || tn.Pkg() == nil || tn.Pkg().Name() != "handlertypes" {
+			continue
+		}
+		seen[tn.Pkg().Path()+"."+tn.Name()] = true
+	}
+
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// bodyStructs returns the body structs of the package: structs named
+// *BodyInput/*BodyOutput and the struct types of the Body field of route
+// *Input/*Output wrappers.
+func bodyStructs(pass *analysis.Pass) []body {
+	specs := make(map[*types.TypeName]*ast.TypeSpec)
+	bodies := make(map[*types.TypeName]bool)
+	for _, ts := range structSpecs(pass) {
+		tn, ok := pass.TypesInfo.Defs[ts.Name].(*types.TypeName)
+		if !ok {
+			continue
+		}
+		specs[tn] = ts
+		name := ts.Name.Name
+		switch {
+		case strings.HasSuffix(name, "BodyInput"), strings.HasSuffix(name, "BodyOutput"):
+			bodies[tn] = true
+		case strings.HasSuffix(name, "Input"), strings.HasSuffix(name, "Output"):
+			if f, _ := analysisutil.PayloadBody(tn.Type().(*types.Named)); f != nil {
+				if named, ok := deref(f.Type()).(*types.Named); ok && named.Obj().Pkg() == pass.Pkg {
+					bodies[named.Obj()] = true
+				}
+			}
+		}
+	}
+
+	var out []body
+	for tn := range bodies {
+		ts, ok := specs[tn]
+		if !ok {
+			continue
+		}
+		st := tn.Type().Underlying().(*types.Struct)
+		if st.NumFields() == 0 {
+			continue
+		}
+		out = append(out, body{
+			Pkg:   pass.Pkg.Path(),
+			Name:  pass.Pkg.Name() + "." + tn.Name(),
+			Pos:   analysisutil.ModulePosition(pass, ts.Name.Pos()),
+			Shape: types.TypeString(st, nil),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Pos < out[j].Pos })
+	return out
+}
+
+func structSpecs(pass *analysis.Pass) []*ast.TypeSpec {
+	var out []*ast.TypeSpec
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if _, ok := ts.Type.(*ast.StructType); ok {
+					out = append(out, ts)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+	return t
+}
+
+// reportModule reports, on func main, handlertypes structs used by a single
+// handler package and identical body structs declared in several handler
+// packages, for every package reachable from the main package.
+func reportModule(pass *analysis.Pass) {
+	declared := make(map[string]sharedType)
+	users := make(map[string][]*types.Package)
+	shapes := make(map[string][]body)
+	for _, pf := range pass.AllPackageFacts() {
+		fact, ok := pf.Fact.(*payloadsFact)
+		if !ok {
+			continue
+		}
+		for _, t := range fact.Declared {
+			declared[pf.Package.Path()+"."+t.Name] = t
+		}
+		for _, name := range fact.Uses {
+			users[name] = append(users[name], pf.Package)
+		}
+		for _, b := range fact.Bodies {
+			shapes[b.Shape] = append(shapes[b.Shape], b)
+		}
+	}
+
+	pos := analysisutil.MainReportPos(pass)
+
+	names := make([]string, 0, len(declared))
+	for name := range declared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pkgs := users[name]
+		if len(pkgs) != 1 || !analysisutil.IsHandlerPackage(pkgs[0].Name()) || pkgs[0].Name() == "handler" {
+			continue
+		}
+		t := declared[name]
+		pass.Reportf(pos, "LINT-054: handlertypes.%s (%s) is only used by package %s and should be moved there", t.Name, t.Pos, pkgs[0].Path())
+	}
+
+	var groups [][]body
+	for _, bodies := range shapes {
+		if len(bodies) < 2 || !acrossPackages(bodies) {
+			continue
+		}
+		sort.Slice(bodies, func(i, j int) bool { return bodies[i].Pos < bodies[j].Pos })
+		groups = append(groups, bodies)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0].Pos < groups[j][0].Pos })
+	for _, bodies := range groups {
+		list := make([]string, len(bodies))
+		for i, b := range bodies {
+			list[i] = fmt.Sprintf("%s (%s)", b.Name, b.Pos)
+		}
+		pass.Reportf(pos, "LINT-054: body structs %s are identical and should be extracted into handlertypes", strings.Join(list, ", "))
+	}
+}
+
+// acrossPackages reports whether bodies are declared in more than one
+// package.
+func acrossPackages(bodies []body) bool {
+	for _, b := range bodies[1:] {
+		if b.Pkg != bodies[0].Pkg {
+			return true
+		}
+	}
+	return false
+}
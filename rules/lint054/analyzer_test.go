@@ -0,0 +1,18 @@
+package lint054_test
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/alexisvisco/relint/rules/lint054"
+)
+
+func TestAnalyzer(t *testing.T) {
+	_, thisFile, _, _ := runtime.Caller(0)
+	testdata := filepath.Join(filepath.Dir(thisFile), "..", "..", "example")
+
+	analysistest.Run(t, testdata, lint054.Analyzer, "lint054/handlertypes", "lint054/userhandler", "lint054/adminhandler", "lint054/cmd")
+}